@@ -9,7 +9,9 @@ import (
 	"io"
 	"mime/multipart"
 	"net/http"
+	"path/filepath"
 	"strings"
+	"time"
 )
 
 // Activity describes a Garmin Connect activity.
@@ -41,6 +43,24 @@ type ActivityType struct {
 	SortOrder    int    `json:"sortOrder"`
 }
 
+// ActivityTypes will list all activity types known by Garmin Connect.
+func (c *Client) ActivityTypes() ([]ActivityType, error) {
+	URL := "https://connect.garmin.com/modern/proxy/activity-service/activity/activityTypes"
+
+	if !c.authenticated() {
+		return nil, ErrNotAuthenticated
+	}
+
+	var types []ActivityType
+
+	err := c.getJSON(URL, &types)
+	if err != nil {
+		return nil, err
+	}
+
+	return types, nil
+}
+
 // Activity will retrieve details about an activity.
 func (c *Client) Activity(activityID int) (*Activity, error) {
 	URL := fmt.Sprintf("https://connect.garmin.com/modern/proxy/activity-service/activity/%d",
@@ -140,8 +160,6 @@ func (c *Client) ExportActivity(id int, w io.Writer, format ActivityFormat) erro
 // ImportActivity will import an activity into Garmin Connect. The activity
 // will be read from file.
 func (c *Client) ImportActivity(file io.Reader, format ActivityFormat) (int, error) {
-	URL := "https://connect.garmin.com/modern/proxy/upload-service/upload/." + format.Extension()
-
 	switch format {
 	case ActivityFormatFIT, ActivityFormatTCX, ActivityFormatGPX:
 		// These are ok.
@@ -149,32 +167,28 @@ func (c *Client) ImportActivity(file io.Reader, format ActivityFormat) (int, err
 		return 0, fmt.Errorf("%s is not supported for import", format.Extension())
 	}
 
-	formData := bytes.Buffer{}
-	writer := multipart.NewWriter(&formData)
-	defer writer.Close()
-
-	activity, err := writer.CreateFormFile("file", "activity."+format.Extension())
+	ids, err := c.upload(file, "activity."+format.Extension(), false)
 	if err != nil {
 		return 0, err
 	}
 
-	_, err = io.Copy(activity, file)
-	if err != nil {
-		return 0, err
+	if len(ids) != 1 {
+		return 0, Error("cannot parse response, no failures and no successes..?")
 	}
 
-	writer.Close()
-
-	req, err := c.newRequest("POST", URL, &formData)
-	if err != nil {
-		return 0, err
-	}
+	return ids[0], nil
+}
 
-	req.Header.Add("content-type", writer.FormDataContentType())
+// upload will upload file to the Garmin Connect upload service. The file
+// type will be deduced from the extension of filename. If async is true,
+// uploads accepted for later processing are considered successful too. The
+// internal IDs of all successfully imported items are returned.
+func (c *Client) upload(file io.Reader, filename string, async bool) ([]int, error) {
+	URL := "https://connect.garmin.com/modern/proxy/upload-service/upload/" + filepath.Ext(filename)
 
-	resp, err := c.do(req)
+	resp, err := c.postFile(URL, file, filename)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
 	defer resp.Body.Close()
 
@@ -195,7 +209,7 @@ func (c *Client) ImportActivity(file io.Reader, format ActivityFormat) (int, err
 
 	err = json.NewDecoder(resp.Body).Decode(&response)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
 
 	// This is ugly.
@@ -207,18 +221,48 @@ func (c *Client) ImportActivity(file io.Reader, format ActivityFormat) (int, err
 			}
 		}
 
-		return 0, errors.New(strings.Join(messages, "; "))
+		return nil, errors.New(strings.Join(messages, "; "))
 	}
 
-	if resp.StatusCode != 201 {
-		return 0, fmt.Errorf("%d: %s", resp.StatusCode, http.StatusText(resp.StatusCode))
+	// Some uploads are processed asynchronously and will return 202.
+	if resp.StatusCode != 201 && !(async && resp.StatusCode == 202) {
+		return nil, fmt.Errorf("%d: %s", resp.StatusCode, http.StatusText(resp.StatusCode))
 	}
 
-	if len(response.ImportResult.Successes) != 1 {
-		return 0, Error("cannot parse response, no failures and no successes..?")
+	ids := make([]int, len(response.ImportResult.Successes))
+	for i, s := range response.ImportResult.Successes {
+		ids[i] = s.InternalID
 	}
 
-	return response.ImportResult.Successes[0].InternalID, nil
+	return ids, nil
+}
+
+// postFile will post file as a multipart form to URL. The caller must
+// close the response body.
+func (c *Client) postFile(URL string, file io.Reader, filename string) (*http.Response, error) {
+	formData := bytes.Buffer{}
+	writer := multipart.NewWriter(&formData)
+
+	part, err := writer.CreateFormFile("file", filename)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = io.Copy(part, file)
+	if err != nil {
+		return nil, err
+	}
+
+	writer.Close()
+
+	req, err := c.newRequest("POST", URL, &formData)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("content-type", writer.FormDataContentType())
+
+	return c.do(req)
 }
 
 // DeleteActivity will permanently delete an activity.
@@ -227,3 +271,100 @@ func (c *Client) DeleteActivity(id int) error {
 
 	return c.write("DELETE", URL, nil, 0)
 }
+
+// ActivitiesRange will list all activities for the authenticated user
+// started between from and to (both inclusive).
+func (c *Client) ActivitiesRange(from time.Time, to time.Time) ([]Activity, error) {
+	if !c.authenticated() {
+		return nil, ErrNotAuthenticated
+	}
+
+	const pageSize = 100
+
+	activities := make([]Activity, 0, pageSize)
+
+	for start := 0; ; start += pageSize {
+		URL := fmt.Sprintf("https://connect.garmin.com/modern/proxy/activitylist-service/activities/search/activities?startDate=%s&endDate=%s&start=%d&limit=%d",
+			formatDate(from),
+			formatDate(to),
+			start,
+			pageSize,
+		)
+
+		var page []Activity
+
+		err := c.getJSON(URL, &page)
+		if err != nil {
+			return nil, err
+		}
+
+		activities = append(activities, page...)
+
+		if len(page) < pageSize {
+			break
+		}
+	}
+
+	return activities, nil
+}
+
+// ActivityHeartRate will retrieve the heart-rate stream recorded during an
+// activity. ErrNotFound will be returned if the activity has no heart-rate
+// data.
+func (c *Client) ActivityHeartRate(activityID int) (Series, error) {
+	URL := fmt.Sprintf("https://connect.garmin.com/modern/proxy/activity-service/activity/%d/details?maxChartSize=100000&maxPolylineSize=0",
+		activityID,
+	)
+
+	var proxy struct {
+		Descriptors []struct {
+			Index int    `json:"metricsIndex"`
+			Key   string `json:"key"`
+		} `json:"metricDescriptors"`
+		Metrics []struct {
+			Metrics []*float64 `json:"metrics"`
+		} `json:"activityDetailMetrics"`
+	}
+
+	err := c.getJSON(URL, &proxy)
+	if err != nil {
+		return nil, err
+	}
+
+	timestampIndex, heartRateIndex := -1, -1
+	for _, d := range proxy.Descriptors {
+		switch d.Key {
+		case "directTimestamp":
+			timestampIndex = d.Index
+		case "directHeartRate":
+			heartRateIndex = d.Index
+		}
+	}
+
+	if timestampIndex < 0 || heartRateIndex < 0 {
+		return nil, ErrNotFound
+	}
+
+	points := make(Series, 0, len(proxy.Metrics))
+	for _, m := range proxy.Metrics {
+		if timestampIndex >= len(m.Metrics) || heartRateIndex >= len(m.Metrics) {
+			continue
+		}
+
+		ts, hr := m.Metrics[timestampIndex], m.Metrics[heartRateIndex]
+		if ts == nil || hr == nil {
+			continue
+		}
+
+		points = append(points, Sample{
+			Timestamp: time.Unix(int64(*ts)/1000, 0),
+			Value:     *hr,
+		})
+	}
+
+	if len(points) == 0 {
+		return nil, ErrNotFound
+	}
+
+	return points, nil
+}
@@ -0,0 +1,107 @@
+package connect
+
+import (
+	"fmt"
+	"sort"
+	"time"
+)
+
+// BloodPressure is a single blood pressure reading.
+type BloodPressure struct {
+	Version   int64  `json:"version"`
+	Systolic  int    `json:"systolic"`  // mmHg
+	Diastolic int    `json:"diastolic"` // mmHg
+	Pulse     int    `json:"pulse"`     // bpm
+	Notes     string `json:"notes"`
+
+	// Category is Garmin's classification of the reading, like "NORMAL" or
+	// "STAGE_1_HIGH".
+	Category string `json:"categoryName"`
+
+	SourceType     string `json:"sourceType"`
+	TimestampLocal Time   `json:"measurementTimestampLocal"`
+	TimestampGMT   Time   `json:"measurementTimestampGMT"`
+}
+
+// BloodPressureRange will retrieve all blood pressure readings between from
+// and to (both inclusive). The readings are sorted by time, newest first.
+func (c *Client) BloodPressureRange(from time.Time, to time.Time) ([]BloodPressure, error) {
+	URL := fmt.Sprintf("https://connect.garmin.com/modern/proxy/bloodpressure-service/bloodpressure/range/%s/%s?includeAll=true",
+		formatDate(from),
+		formatDate(to))
+
+	if !c.authenticated() {
+		return nil, ErrNotAuthenticated
+	}
+
+	var proxy struct {
+		Summaries []struct {
+			Measurements []BloodPressure `json:"measurements"`
+		} `json:"measurementSummaries"`
+	}
+
+	err := c.getJSON(URL, &proxy)
+	if err != nil {
+		return nil, err
+	}
+
+	var readings []BloodPressure
+	for _, s := range proxy.Summaries {
+		readings = append(readings, s.Measurements...)
+	}
+
+	sort.Slice(readings, func(i, j int) bool {
+		return readings[i].TimestampGMT.After(readings[j].TimestampGMT.Time)
+	})
+
+	return readings, nil
+}
+
+// AddBloodPressure will add a manual blood pressure reading taken at
+// timestamp. pulse and notes are optional.
+func (c *Client) AddBloodPressure(timestamp time.Time, systolic int, diastolic int, pulse int, notes string) error {
+	URL := "https://connect.garmin.com/modern/proxy/bloodpressure-service/bloodpressure"
+
+	if !c.authenticated() {
+		return ErrNotAuthenticated
+	}
+
+	if systolic <= 0 || diastolic <= 0 {
+		return Error("systolic and diastolic pressure is required")
+	}
+
+	const layout = "2006-01-02T15:04:05.00"
+
+	payload := struct {
+		TimestampLocal string `json:"measurementTimestampLocal"`
+		TimestampGMT   string `json:"measurementTimestampGMT"`
+		Systolic       int    `json:"systolic"`
+		Diastolic      int    `json:"diastolic"`
+		Pulse          int    `json:"pulse,omitempty"`
+		Notes          string `json:"notes,omitempty"`
+		SourceType     string `json:"sourceType"`
+	}{
+		TimestampLocal: timestamp.Format(layout),
+		TimestampGMT:   timestamp.UTC().Format(layout),
+		Systolic:       systolic,
+		Diastolic:      diastolic,
+		Pulse:          pulse,
+		Notes:          notes,
+		SourceType:     "MANUAL",
+	}
+
+	return c.write("POST", URL, payload, 200)
+}
+
+// DeleteBloodPressure will delete a single blood pressure reading.
+func (c *Client) DeleteBloodPressure(reading BloodPressure) error {
+	URL := fmt.Sprintf("https://connect.garmin.com/modern/proxy/bloodpressure-service/bloodpressure/%s/%d",
+		formatDate(reading.TimestampLocal.Time),
+		reading.Version)
+
+	if !c.authenticated() {
+		return ErrNotAuthenticated
+	}
+
+	return c.write("DELETE", URL, nil, 204)
+}
@@ -0,0 +1,144 @@
+package connect
+
+import (
+	"fmt"
+	"time"
+)
+
+// BodyBatteryEvent is an event affecting the body battery, like sleep, an
+// activity or a period of stress.
+type BodyBatteryEvent struct {
+	Type          string
+	Start         time.Time
+	Duration      time.Duration
+	Impact        int
+	Feedback      string
+	ShortFeedback string
+	ActivityID    int64
+	ActivityName  string
+}
+
+// BodyBattery is the body battery report for a single day.
+type BodyBattery struct {
+	Date    Date
+	Charged int
+	Drained int
+	High    int
+	Low     int
+	Values  Series
+}
+
+// bodyBatteryEventProxy is used to deserialize events to proper Go types.
+type bodyBatteryEventProxy struct {
+	Type          string  `json:"eventType"`
+	StartGMT      Time    `json:"eventStartTimeGmt"`
+	Offset        int64   `json:"timezoneOffset"`
+	Duration      float64 `json:"durationInMilliseconds"`
+	Impact        int     `json:"bodyBatteryImpact"`
+	Feedback      string  `json:"feedbackType"`
+	ShortFeedback string  `json:"shortFeedback"`
+}
+
+func (p *bodyBatteryEventProxy) event() BodyBatteryEvent {
+	zone := time.FixedZone("", int(p.Offset/1000))
+
+	return BodyBatteryEvent{
+		Type:          p.Type,
+		Start:         p.StartGMT.In(zone),
+		Duration:      time.Duration(p.Duration * float64(time.Millisecond)),
+		Impact:        p.Impact,
+		Feedback:      p.Feedback,
+		ShortFeedback: p.ShortFeedback,
+	}
+}
+
+// BodyBattery will retrieve the body battery reports for all days between
+// from and to (both inclusive).
+func (c *Client) BodyBattery(from time.Time, to time.Time) ([]BodyBattery, error) {
+	URL := fmt.Sprintf("https://connect.garmin.com/modern/proxy/wellness-service/wellness/bodyBattery/reports/daily?startDate=%s&endDate=%s",
+		formatDate(from),
+		formatDate(to),
+	)
+
+	if !c.authenticated() {
+		return nil, ErrNotAuthenticated
+	}
+
+	var proxy []struct {
+		Date       Date            `json:"date"`
+		Charged    int             `json:"charged"`
+		Drained    int             `json:"drained"`
+		StartGMT   Time            `json:"startTimestampGMT"`
+		StartLocal Time            `json:"startTimestampLocal"`
+		Values     [][]interface{} `json:"bodyBatteryValuesArray"`
+	}
+
+	err := c.getJSON(URL, &proxy)
+	if err != nil {
+		return nil, err
+	}
+
+	reports := make([]BodyBattery, len(proxy))
+	for i, p := range proxy {
+		reports[i].Date = p.Date
+		reports[i].Charged = p.Charged
+		reports[i].Drained = p.Drained
+		reports[i].Values = parseSeries(p.Values, 1, localZone(p.StartGMT, p.StartLocal))
+		reports[i].High = int(reports[i].Values.Max())
+		reports[i].Low = int(reports[i].Values.Min())
+	}
+
+	return reports, nil
+}
+
+// BodyBatteryEvents will retrieve the events affecting the body battery
+// during date.
+func (c *Client) BodyBatteryEvents(date time.Time) ([]BodyBatteryEvent, error) {
+	URL := fmt.Sprintf("https://connect.garmin.com/modern/proxy/wellness-service/wellness/bodyBattery/events/%s",
+		formatDate(date))
+
+	if !c.authenticated() {
+		return nil, ErrNotAuthenticated
+	}
+
+	var proxy []struct {
+		Event        bodyBatteryEventProxy `json:"event"`
+		ActivityID   int64                 `json:"activityId"`
+		ActivityName string                `json:"activityName"`
+	}
+
+	err := c.getJSON(URL, &proxy)
+	if err != nil {
+		return nil, err
+	}
+
+	events := make([]BodyBatteryEvent, len(proxy))
+	for i, p := range proxy {
+		events[i] = p.Event.event()
+		events[i].ActivityID = p.ActivityID
+		events[i].ActivityName = p.ActivityName
+	}
+
+	return events, nil
+}
+
+// IntradayBodyBattery will retrieve the body battery level during date.
+func (c *Client) IntradayBodyBattery(date time.Time) (Series, error) {
+	return c.IntradayBodyBatteryRange(date, date)
+}
+
+// IntradayBodyBatteryRange will retrieve the body battery level for all days
+// between from and to (both inclusive).
+func (c *Client) IntradayBodyBatteryRange(from time.Time, to time.Time) (Series, error) {
+	reports, err := c.BodyBattery(from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	series := make(Series, 0, len(reports)*100)
+	for _, report := range reports {
+		series = append(series, report.Values...)
+	}
+
+	return series, nil
+}
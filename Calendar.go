@@ -2,6 +2,7 @@ package connect
 
 import (
 	"fmt"
+	"time"
 )
 
 // CalendarYear describes a Garmin Connect calendar year
@@ -45,7 +46,15 @@ type CalendarWeek struct {
 	CalendarItems    []CalendarItem `json:"calendarItems"`
 }
 
-// CalendarItem describes an activity displayed on a Garmin Connect calendar
+// Known values of CalendarItem.ItemType.
+const (
+	CalendarItemActivity = "activity"
+	CalendarItemWorkout  = "workout"
+	CalendarItemEvent    = "event"
+)
+
+// CalendarItem describes an activity, a scheduled workout or an event
+// displayed on a Garmin Connect calendar
 type CalendarItem struct {
 	ID                       int     `json:"id"`
 	ItemType                 string  `json:"itemType"`
@@ -63,6 +72,7 @@ type CalendarItem struct {
 	AutoCalcCalories         bool    `json:"autoCalcCalories"`
 	ProtectedWorkoutSchedule bool    `json:"protectedWorkoutSchedule"`
 	IsParent                 bool    `json:"isParent"`
+	WorkoutID                int64   `json:"workoutId"`
 }
 
 // CalendarYear will get the activity summaries  and list of days active for a given year
@@ -109,3 +119,39 @@ func (c *Client) CalendarWeek(year int, month int, week int) (*CalendarWeek, err
 
 	return calendarWeek, nil
 }
+
+// CalendarItems will get all calendar items between from and to (both
+// inclusive).
+func (c *Client) CalendarItems(from time.Time, to time.Time) ([]CalendarItem, error) {
+	first := NewDate(from).Time()
+	last := NewDate(to).Time()
+
+	var items []CalendarItem
+
+	month := time.Date(first.Year(), first.Month(), 1, 0, 0, 0, 0, time.UTC)
+	for !month.After(last) {
+		calendar, err := c.CalendarMonth(month.Year(), int(month.Month()))
+		if err != nil {
+			return nil, err
+		}
+
+		for _, item := range calendar.CalendarItems {
+			date := item.Date.Time()
+			if date.Before(first) || date.After(last) {
+				continue
+			}
+
+			// Months can include days from neighbouring months, these
+			// are included when fetching those months.
+			if date.Year() != month.Year() || date.Month() != month.Month() {
+				continue
+			}
+
+			items = append(items, item)
+		}
+
+		month = month.AddDate(0, 1, 0)
+	}
+
+	return items, nil
+}
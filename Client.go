@@ -14,6 +14,7 @@ import (
 	"net/url"
 	"regexp"
 	"strings"
+	"sync"
 	"time"
 )
 
@@ -52,7 +53,9 @@ const (
 	cflbCookieName = "__cflb"
 )
 
-// Client can be used to access the unofficial Garmin Connect API.
+// Client can be used to access the unofficial Garmin Connect API. Client is
+// safe for concurrent use once configured, an expired session will be
+// renewed once for all concurrent requests.
 type Client struct {
 	Email     string         `json:"email"`
 	Password  string         `json:"password"`
@@ -68,6 +71,13 @@ type Client struct {
 	autoRenewSession bool
 	debugLogger      Logger
 	dumpWriter       io.Writer
+
+	// sessionLock guards SessionID, LoadBalancerID and Profile while
+	// requests are running.
+	sessionLock sync.RWMutex
+
+	// dumpLock keeps dumps of concurrent requests from interleaving.
+	dumpLock sync.Mutex
 }
 
 // Option is the type to set options on the client.
@@ -164,6 +174,9 @@ func (c *Client) dump(reqResp interface{}) {
 		return
 	}
 
+	c.dumpLock.Lock()
+	defer c.dumpLock.Unlock()
+
 	var dump []byte
 	switch obj := reqResp.(type) {
 	case *http.Request:
@@ -181,6 +194,15 @@ func (c *Client) dump(reqResp interface{}) {
 
 // addCookies adds needed cookies to a http request if the values are known.
 func (c *Client) addCookies(req *http.Request) {
+	c.sessionLock.RLock()
+	defer c.sessionLock.RUnlock()
+
+	c.addCookiesLocked(req)
+}
+
+// addCookiesLocked is like addCookies, but sessionLock must be held by the
+// caller.
+func (c *Client) addCookiesLocked(req *http.Request) {
 	if c.SessionID != "" {
 		req.AddCookie(&http.Cookie{
 			Value: c.SessionID,
@@ -197,6 +219,18 @@ func (c *Client) addCookies(req *http.Request) {
 }
 
 func (c *Client) newRequest(method string, url string, body io.Reader) (*http.Request, error) {
+	req, err := newBareRequest(method, url, body)
+	if err != nil {
+		return nil, err
+	}
+
+	c.addCookies(req)
+
+	return req, nil
+}
+
+// newBareRequest returns a new request without cookies.
+func newBareRequest(method string, url string, body io.Reader) (*http.Request, error) {
 	req, err := http.NewRequest(method, url, body)
 	if err != nil {
 		return nil, err
@@ -208,8 +242,6 @@ func (c *Client) newRequest(method string, url string, body io.Reader) (*http.Re
 	// Yep. This is needed for requests sent to the API. No idea what it does.
 	req.Header.Add("nk", "NT")
 
-	c.addCookies(req)
-
 	return req, nil
 }
 
@@ -267,6 +299,34 @@ func (c *Client) write(method string, url string, payload interface{}, expectedS
 	return nil
 }
 
+// writeJSON will write payload as JSON to the API and decode the response
+// into target.
+func (c *Client) writeJSON(method string, url string, payload interface{}, target interface{}) error {
+	b, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	req, err := c.newRequest(method, url, bytes.NewReader(b))
+	if err != nil {
+		return err
+	}
+
+	req.Header.Add("content-type", "application/json")
+
+	resp, err := c.do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated {
+		return fmt.Errorf("HTTP %s returned %d", method, resp.StatusCode)
+	}
+
+	return json.NewDecoder(resp.Body).Decode(target)
+}
+
 // handleForbidden will try to extract an error message from the response.
 func (c *Client) handleForbidden(resp *http.Response) error {
 	defer resp.Body.Close()
@@ -301,6 +361,12 @@ func (c *Client) do(req *http.Request) (*http.Response, error) {
 		}
 	}
 
+	// Remember the session used, to tell if another request renewed it
+	// while this one was running.
+	c.sessionLock.RLock()
+	usedSessionID := c.SessionID
+	c.sessionLock.RUnlock()
+
 	c.dump(req)
 	t0 := time.Now()
 	resp, err := c.client.Do(req)
@@ -322,18 +388,11 @@ func (c *Client) do(req *http.Request) (*http.Response, error) {
 			resp.Body.Close()
 			c.debugLogger.Printf("Session invalid, requesting new session")
 
-			// Wups. Our session got invalidated.
-			c.SetOptions(SessionID(""))
-			c.SetOptions(LoadBalancerID(""))
-
-			// Re-new session.
-			err = c.Authenticate()
+			err = c.renewSession(usedSessionID)
 			if err != nil {
 				return nil, err
 			}
 
-			c.debugLogger.Printf("Successfully authenticated as %s", c.Email)
-
 			// Replace the drained body
 			req.Body = save
 
@@ -398,12 +457,52 @@ func (c *Client) Download(url string, w io.Writer) error {
 }
 
 func (c *Client) authenticated() bool {
+	c.sessionLock.RLock()
+	defer c.sessionLock.RUnlock()
+
 	return c.SessionID != ""
 }
 
+// renewSession will authenticate again if the session is still
+// usedSessionID. If another request already renewed the session, nothing
+// is done.
+func (c *Client) renewSession(usedSessionID string) error {
+	c.sessionLock.Lock()
+	defer c.sessionLock.Unlock()
+
+	if c.SessionID != usedSessionID {
+		c.debugLogger.Printf("Session already renewed")
+
+		return nil
+	}
+
+	// Wups. Our session got invalidated.
+	c.SessionID = ""
+	c.LoadBalancerID = ""
+
+	// Re-new session.
+	err := c.authenticate()
+	if err != nil {
+		return err
+	}
+
+	c.debugLogger.Printf("Successfully authenticated as %s", c.Email)
+
+	return nil
+}
+
 // Authenticate using a Garmin Connect username and password provided by
 // the Credentials option function.
 func (c *Client) Authenticate() error {
+	c.sessionLock.Lock()
+	defer c.sessionLock.Unlock()
+
+	return c.authenticate()
+}
+
+// authenticate is like Authenticate, but sessionLock must be held by the
+// caller.
+func (c *Client) authenticate() error {
 	// We cannot use Client.do() in this function, since this function can be
 	// called from do() upon session renewal.
 	URL := "https://sso.garmin.com/sso/signin" +
@@ -447,10 +546,11 @@ func (c *Client) Authenticate() error {
 		"_csrf":    {csrfToken},
 	}
 
-	req, err = c.newRequest("POST", URL, strings.NewReader(formValues.Encode()))
+	req, err = newBareRequest("POST", URL, strings.NewReader(formValues.Encode()))
 	if err != nil {
 		return nil
 	}
+	c.addCookiesLocked(req)
 	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
 	req.Header.Set("Referer", URL)
 
@@ -485,7 +585,8 @@ func (c *Client) Authenticate() error {
 	c.debugLogger.Printf("Requesting session at ticket URL %s", ticketURL)
 
 	// Use ticket to request session.
-	req, _ = c.newRequest("GET", ticketURL, nil)
+	req, _ = newBareRequest("GET", ticketURL, nil)
+	c.addCookiesLocked(req)
 	c.dump(req)
 	resp, err = c.client.Do(req)
 	if err != nil {
@@ -499,13 +600,13 @@ func (c *Client) Authenticate() error {
 		if cookie.Name == cflbCookieName {
 			c.debugLogger.Printf("Found load balancer cookie with value %s", cookie.Value)
 
-			c.SetOptions(LoadBalancerID(cookie.Value))
+			c.LoadBalancerID = cookie.Value
 		}
 
 		if cookie.Name == sessionCookieName {
 			c.debugLogger.Printf("Found session cookie with value %s", cookie.Value)
 
-			c.SetOptions(SessionID(cookie.Value))
+			c.SessionID = cookie.Value
 		}
 	}
 
@@ -520,7 +621,8 @@ func (c *Client) Authenticate() error {
 	location := resp.Header.Get("Location")
 	c.debugLogger.Printf("Redeeming session id at %s", location)
 
-	req, _ = c.newRequest("GET", location, nil)
+	req, _ = newBareRequest("GET", location, nil)
+	c.addCookiesLocked(req)
 	c.dump(req)
 	resp, err = c.client.Do(req)
 	if err != nil {
@@ -528,11 +630,17 @@ func (c *Client) Authenticate() error {
 	}
 	c.dump(resp)
 
-	c.Profile, err = extractSocialProfile(resp.Body)
+	profile, err := extractSocialProfile(resp.Body)
 	if err != nil {
 		return err
 	}
 
+	// Concurrent requests may be reading the profile, so it's only
+	// replaced when a different user logged in.
+	if c.Profile == nil || c.Profile.ProfileID != profile.ProfileID {
+		c.Profile = profile
+	}
+
 	resp.Body.Close()
 
 	return nil
@@ -598,18 +706,16 @@ func (c *Client) Signout() error {
 		return err
 	}
 
-	if c.SessionID == "" {
-		return nil
-	}
-
 	resp, err := c.client.Do(req)
 	if err != nil {
 		return err
 	}
 	defer resp.Body.Close()
 
-	c.SetOptions(SessionID(""))
-	c.SetOptions(LoadBalancerID(""))
+	c.sessionLock.Lock()
+	c.SessionID = ""
+	c.LoadBalancerID = ""
+	c.sessionLock.Unlock()
 
 	return nil
 }
@@ -0,0 +1,140 @@
+package connect
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// Course is a course that can be followed on a device.
+type Course struct {
+	ID            int64        `json:"courseId"`
+	Name          string       `json:"courseName"`
+	Description   string       `json:"description"`
+	ActivityType  ActivityType `json:"activityType"`
+	Distance      float64      `json:"distanceInMeters"`
+	ElevationGain float64      `json:"elevationGainInMeters"`
+	ElevationLoss float64      `json:"elevationLossInMeters"`
+	Created       Time         `json:"createdDate"`
+	Updated       Time         `json:"updatedDate"`
+}
+
+// Courses will list the courses of the authenticated user.
+func (c *Client) Courses() ([]Course, error) {
+	URL := "https://connect.garmin.com/modern/proxy/web-gateway/course/owner/"
+
+	if !c.authenticated() {
+		return nil, ErrNotAuthenticated
+	}
+
+	var proxy struct {
+		Courses []Course `json:"coursesForUser"`
+	}
+
+	err := c.getJSON(URL, &proxy)
+	if err != nil {
+		return nil, err
+	}
+
+	return proxy.Courses, nil
+}
+
+// Course will retrieve details about a course.
+func (c *Client) Course(id int64) (*Course, error) {
+	URL := fmt.Sprintf("https://connect.garmin.com/modern/proxy/course-service/course/%d", id)
+
+	if !c.authenticated() {
+		return nil, ErrNotAuthenticated
+	}
+
+	course := new(Course)
+
+	err := c.getJSON(URL, course)
+	if err != nil {
+		return nil, err
+	}
+
+	return course, nil
+}
+
+// DeleteCourse will delete a course.
+func (c *Client) DeleteCourse(id int64) error {
+	URL := fmt.Sprintf("https://connect.garmin.com/modern/proxy/course-service/course/%d", id)
+
+	if !c.authenticated() {
+		return ErrNotAuthenticated
+	}
+
+	return c.write("DELETE", URL, nil, 0)
+}
+
+// ExportCourse will export a course as GPX or FIT. The course will be
+// written to w.
+func (c *Client) ExportCourse(id int64, w io.Writer, format ActivityFormat) error {
+	var URL string
+
+	switch format {
+	case ActivityFormatGPX:
+		URL = fmt.Sprintf("https://connect.garmin.com/modern/proxy/course-service/course/gpx/%d", id)
+	case ActivityFormatFIT:
+		URL = fmt.Sprintf("https://connect.garmin.com/modern/proxy/course-service/course/fit/%d/0?elevation=true", id)
+	default:
+		return fmt.Errorf("%s is not supported for course export", format.Extension())
+	}
+
+	return c.Download(URL, w)
+}
+
+// ImportCourse will create a course from a GPX or FIT track read from
+// file. The format is deduced from the extension of filename. If name is
+// empty, the name from the file will be used. Elevation and course points
+// from the file are preserved.
+func (c *Client) ImportCourse(file io.Reader, filename string, name string) (*Course, error) {
+	URL := "https://connect.garmin.com/modern/proxy/course-service/course/import"
+
+	if !c.authenticated() {
+		return nil, ErrNotAuthenticated
+	}
+
+	format, err := FormatFromFilename(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	if format != ActivityFormatGPX && format != ActivityFormatFIT {
+		return nil, fmt.Errorf("%s is not supported for course import", format.Extension())
+	}
+
+	resp, err := c.postFile(URL, file, filename)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%d: %s", resp.StatusCode, http.StatusText(resp.StatusCode))
+	}
+
+	// The parsed course is kept as-is, to avoid losing geo points and
+	// course points we don't know about.
+	var parsed map[string]interface{}
+
+	err = json.NewDecoder(resp.Body).Decode(&parsed)
+	if err != nil {
+		return nil, err
+	}
+
+	if name != "" {
+		parsed["courseName"] = name
+	}
+
+	course := new(Course)
+
+	err = c.writeJSON("POST", "https://connect.garmin.com/modern/proxy/course-service/course", parsed, course)
+	if err != nil {
+		return nil, err
+	}
+
+	return course, nil
+}
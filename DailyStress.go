@@ -22,6 +22,7 @@ type DailyStress struct {
 	Max           int    `json:"maxStressLevel"`
 	Average       int    `json:"avgStressLevel"`
 	Values        []StressPoint
+	BodyBattery   Series
 }
 
 // DailyStress will retrieve stress levels for date.
@@ -36,7 +37,8 @@ func (c *Client) DailyStress(date time.Time) (*DailyStress, error) {
 	// We use a proxy object to deserialize the values to proper Go types.
 	var proxy struct {
 		DailyStress
-		StressValuesArray [][2]int64 `json:"stressValuesArray"`
+		StressValuesArray      [][2]int64      `json:"stressValuesArray"`
+		BodyBatteryValuesArray [][]interface{} `json:"bodyBatteryValuesArray"`
 	}
 
 	err := c.getJSON(URL, &proxy)
@@ -47,10 +49,37 @@ func (c *Client) DailyStress(date time.Time) (*DailyStress, error) {
 	ret := &proxy.DailyStress
 	ret.Values = make([]StressPoint, len(proxy.StressValuesArray))
 
+	zone := localZone(ret.StartGMT, ret.StartLocal)
+
 	for i, point := range proxy.StressValuesArray {
-		ret.Values[i].Timestamp = time.Unix(point[0]/1000, 0)
+		ret.Values[i].Timestamp = time.Unix(point[0]/1000, 0).In(zone)
 		ret.Values[i].Value = int(point[1])
 	}
 
+	// Body battery values are [timestamp, status, level, version].
+	ret.BodyBattery = parseSeries(proxy.BodyBatteryValuesArray, 2, zone)
+
 	return &proxy.DailyStress, nil
 }
+
+// DailyStressRange will retrieve stress levels for all days between from and
+// to (both inclusive).
+func (c *Client) DailyStressRange(from time.Time, to time.Time) ([]DailyStress, error) {
+	days := make([]DailyStress, numDays(from, to))
+
+	err := forEachDay(from, to, func(i int, date time.Time) error {
+		stress, err := c.DailyStress(date)
+		if err != nil {
+			return err
+		}
+
+		days[i] = *stress
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return days, nil
+}
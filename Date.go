@@ -85,3 +85,12 @@ func Today() Date {
 
 	return d
 }
+
+// NewDate will return the Date of t.
+func NewDate(t time.Time) Date {
+	d := Date{}
+
+	d.Year, d.Month, d.DayOfMonth = t.Date()
+
+	return d
+}
@@ -0,0 +1,156 @@
+package connect
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Device is a device registered to the authenticated user.
+type Device struct {
+	ID             int64  `json:"deviceId"`
+	UnitID         int64  `json:"unitId"`
+	ProductName    string `json:"productDisplayName"`
+	DisplayName    string `json:"displayName"`
+	SerialNumber   string `json:"serialNumber"`
+	PartNumber     string `json:"partNumber"`
+	Firmware       string `json:"currentFirmwareVersion"`
+	Status         string `json:"deviceStatus"`
+	BatteryStatus  string `json:"batteryStatus"`
+	BatteryLevel   int    `json:"batteryLevel"` // percent
+	Registered     Time   `json:"registeredDate"`
+	LastSync       Time   `json:"lastSyncTime"`
+	PrimaryTracker bool   `json:"primaryActivityTrackerIndicator"`
+	ApplicationKey string `json:"applicationKey"`
+	ImageURL       string `json:"imageUrl"`
+}
+
+// Devices will list all devices registered to the authenticated user.
+func (c *Client) Devices() ([]Device, error) {
+	URL := "https://connect.garmin.com/modern/proxy/device-service/deviceregistration/devices"
+
+	if !c.authenticated() {
+		return nil, ErrNotAuthenticated
+	}
+
+	var devices []Device
+
+	err := c.getJSON(URL, &devices)
+	if err != nil {
+		return nil, err
+	}
+
+	return devices, nil
+}
+
+// DeviceSettings is the settings of a device. The available settings vary
+// between devices, so they are accessed by path like "alarms" or
+// "activityTracking.moveAlertEnabled". Settings not touched are written
+// back unchanged.
+type DeviceSettings struct {
+	DeviceID int64
+	values   map[string]interface{}
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (s *DeviceSettings) UnmarshalJSON(value []byte) error {
+	// Numbers are kept as json.Number to be written back exactly.
+	dec := json.NewDecoder(bytes.NewReader(value))
+	dec.UseNumber()
+
+	return dec.Decode(&s.values)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (s *DeviceSettings) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.values)
+}
+
+// Keys returns all top level settings sorted by name.
+func (s *DeviceSettings) Keys() []string {
+	keys := make([]string, 0, len(s.values))
+	for key := range s.values {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
+
+// Get returns the setting at path. The second return value is false if
+// the setting does not exist.
+func (s *DeviceSettings) Get(path string) (interface{}, bool) {
+	var current interface{} = s.values
+
+	for _, key := range strings.Split(path, ".") {
+		m, ok := current.(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+
+		current, ok = m[key]
+		if !ok {
+			return nil, false
+		}
+	}
+
+	return current, true
+}
+
+// Set will change the setting at path. Only existing settings can be
+// changed, as devices will ignore settings they don't know.
+func (s *DeviceSettings) Set(path string, value interface{}) error {
+	keys := strings.Split(path, ".")
+	m := s.values
+
+	for _, key := range keys[:len(keys)-1] {
+		next, ok := m[key].(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("unknown setting '%s'", path)
+		}
+
+		m = next
+	}
+
+	last := keys[len(keys)-1]
+	if _, found := m[last]; !found {
+		return fmt.Errorf("unknown setting '%s'", path)
+	}
+
+	m[last] = value
+
+	return nil
+}
+
+// DeviceSettings will retrieve the settings of a device.
+func (c *Client) DeviceSettings(deviceID int64) (*DeviceSettings, error) {
+	URL := fmt.Sprintf("https://connect.garmin.com/modern/proxy/device-service/deviceservice/device-info/settings/%d", deviceID)
+
+	if !c.authenticated() {
+		return nil, ErrNotAuthenticated
+	}
+
+	settings := &DeviceSettings{DeviceID: deviceID}
+
+	err := c.getJSON(URL, settings)
+	if err != nil {
+		return nil, err
+	}
+
+	return settings, nil
+}
+
+// UpdateDeviceSettings will write settings back to the device. The
+// changes will be applied on the next sync.
+func (c *Client) UpdateDeviceSettings(settings *DeviceSettings) error {
+	URL := fmt.Sprintf("https://connect.garmin.com/modern/proxy/device-service/deviceservice/device-info/settings/%d", settings.DeviceID)
+
+	if !c.authenticated() {
+		return ErrNotAuthenticated
+	}
+
+	return c.write("PUT", URL, settings, 0)
+}
@@ -0,0 +1,50 @@
+package connect
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestDeviceSettings(t *testing.T) {
+	in := `{"deviceId":123,"unknown":{"a":1.50},"activityTracking":{"moveAlertEnabled":false,"goal":7500},"alarms":[]}`
+
+	settings := &DeviceSettings{}
+
+	err := json.Unmarshal([]byte(in), settings)
+	if err != nil {
+		t.Fatalf("Failed to parse settings: %s", err.Error())
+	}
+
+	value, found := settings.Get("activityTracking.goal")
+	if !found || value.(json.Number).String() != "7500" {
+		t.Errorf("Expected goal 7500, got %v", value)
+	}
+
+	err = settings.Set("activityTracking.moveAlertEnabled", true)
+	if err != nil {
+		t.Errorf("Failed to set setting: %s", err.Error())
+	}
+
+	err = settings.Set("activityTracking.nonExisting", true)
+	if err == nil {
+		t.Errorf("Expected error when setting unknown setting")
+	}
+
+	err = settings.Set("alarms.first", true)
+	if err == nil {
+		t.Errorf("Expected error when setting inside non-object")
+	}
+
+	out, err := json.Marshal(settings)
+	if err != nil {
+		t.Fatalf("Failed to marshal settings: %s", err.Error())
+	}
+
+	// Unknown settings must survive unchanged.
+	for _, expected := range []string{`"unknown":{"a":1.50}`, `"moveAlertEnabled":true`, `"deviceId":123`} {
+		if !strings.Contains(string(out), expected) {
+			t.Errorf("Expected %s in %s", expected, out)
+		}
+	}
+}
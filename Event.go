@@ -0,0 +1,321 @@
+package connect
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// Event is a race or another event on the Garmin Connect event calendar.
+type Event struct {
+	ID        int64
+	Name      string
+	Date      Date
+	Type      string // Sport like "running" or "cycling".
+	Location  string
+	StartTime string // Local time of day as hh:mm.
+	TimeZone  string
+	URL       string
+	Note      string
+
+	// Distance is the race distance in meters.
+	Distance float64
+
+	// GoalTime is the targeted finish time.
+	GoalTime time.Duration
+
+	// Primary events are what training plans and race predictions work
+	// towards.
+	Primary bool
+
+	// Training events are not races.
+	Training bool
+
+	// raw is the event as retrieved from Garmin Connect. It's used to write
+	// back fields not modelled by Event unchanged.
+	raw map[string]interface{}
+
+	// distance and goalTime are the decoded values of Distance and
+	// GoalTime, used to detect changes.
+	distance float64
+	goalTime time.Duration
+}
+
+// eventProxy is the JSON representation of Event.
+type eventProxy struct {
+	ID        int64  `json:"id,omitempty"`
+	Name      string `json:"eventName"`
+	Date      Date   `json:"date"`
+	Type      string `json:"eventType"`
+	Location  string `json:"location,omitempty"`
+	URL       string `json:"url,omitempty"`
+	Note      string `json:"note,omitempty"`
+	Race      bool   `json:"race"`
+	TimeLocal *struct {
+		StartTime string `json:"startTimeHhMm,omitempty"`
+		TimeZone  string `json:"timeZoneId,omitempty"`
+	} `json:"eventTimeLocal,omitempty"`
+	CompletionTarget *eventValue `json:"completionTarget,omitempty"`
+	Customization    struct {
+		CustomGoal *eventValue `json:"customGoal,omitempty"`
+		Primary    bool        `json:"isPrimaryEvent"`
+		Training   bool        `json:"isTrainingEvent"`
+	} `json:"eventCustomization"`
+}
+
+// eventValue is a value with a unit as used by events.
+type eventValue struct {
+	Value    float64 `json:"value"`
+	Unit     string  `json:"unit"`
+	UnitType string  `json:"unitType"`
+}
+
+// eventDistanceUnits maps the distance units used by events to meters.
+var eventDistanceUnits = map[string]float64{
+	"meter":     1.0,
+	"kilometer": 1000.0,
+	"mile":      1609.344,
+	"yard":      0.9144,
+	"foot":      0.3048,
+}
+
+// MarshalJSON implements json.Marshaler. Fields not modelled by Event are
+// written back as they were retrieved, and the distance and goal keep their
+// original unit unless changed.
+func (e *Event) MarshalJSON() ([]byte, error) {
+	m := copyJSONObject(e.raw)
+
+	setJSON(m, "id", e.ID, e.ID == 0)
+	m["eventName"] = e.Name
+	m["date"] = e.Date
+	m["eventType"] = e.Type
+	setJSON(m, "location", e.Location, e.Location == "")
+	setJSON(m, "url", e.URL, e.URL == "")
+	setJSON(m, "note", e.Note, e.Note == "")
+	m["race"] = !e.Training
+
+	if e.StartTime == "" && e.TimeZone == "" {
+		delete(m, "eventTimeLocal")
+	} else {
+		timeLocal := jsonObject(m, "eventTimeLocal")
+		setJSON(timeLocal, "startTimeHhMm", e.StartTime, e.StartTime == "")
+		setJSON(timeLocal, "timeZoneId", e.TimeZone, e.TimeZone == "")
+	}
+
+	if e.Distance != e.distance {
+		setJSON(m, "completionTarget", eventValue{e.Distance, "meter", "distance"}, e.Distance <= 0.0)
+	}
+
+	customization := jsonObject(m, "eventCustomization")
+	if e.GoalTime != e.goalTime {
+		setJSON(customization, "customGoal", eventValue{e.GoalTime.Seconds(), "second", "time"}, e.GoalTime <= 0)
+	}
+	customization["isPrimaryEvent"] = e.Primary
+	customization["isTrainingEvent"] = e.Training
+
+	// New events are private.
+	if _, found := m["eventPrivacy"]; !found {
+		m["eventPrivacy"] = map[string]interface{}{"label": "PRIVATE"}
+	}
+
+	return json.Marshal(m)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (e *Event) UnmarshalJSON(value []byte) error {
+	var proxy eventProxy
+
+	err := json.Unmarshal(value, &proxy)
+	if err != nil {
+		return err
+	}
+
+	// Numbers are kept as json.Number to be written back exactly.
+	var raw map[string]interface{}
+	dec := json.NewDecoder(bytes.NewReader(value))
+	dec.UseNumber()
+
+	err = dec.Decode(&raw)
+	if err != nil {
+		return err
+	}
+
+	*e = Event{
+		ID:       proxy.ID,
+		Name:     proxy.Name,
+		Date:     proxy.Date,
+		Type:     proxy.Type,
+		Location: proxy.Location,
+		URL:      proxy.URL,
+		Note:     proxy.Note,
+		Primary:  proxy.Customization.Primary,
+		Training: proxy.Customization.Training,
+		raw:      raw,
+	}
+
+	if proxy.TimeLocal != nil {
+		e.StartTime = proxy.TimeLocal.StartTime
+		e.TimeZone = proxy.TimeLocal.TimeZone
+	}
+
+	// Distances in unknown units are left at zero, and written back
+	// unchanged.
+	if t := proxy.CompletionTarget; t != nil && t.UnitType == "distance" {
+		e.Distance = t.Value * eventDistanceUnits[t.Unit]
+	}
+
+	if g := proxy.Customization.CustomGoal; g != nil && g.UnitType == "time" && g.Unit == "second" {
+		e.GoalTime = time.Duration(g.Value * float64(time.Second))
+	}
+
+	e.distance = e.Distance
+	e.goalTime = e.GoalTime
+
+	return nil
+}
+
+// copyJSONObject returns a deep copy of a decoded JSON object. A nil object
+// results in an empty object.
+func copyJSONObject(m map[string]interface{}) map[string]interface{} {
+	c := make(map[string]interface{}, len(m))
+	for key, value := range m {
+		c[key] = copyJSONValue(value)
+	}
+
+	return c
+}
+
+func copyJSONValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		return copyJSONObject(v)
+	case []interface{}:
+		c := make([]interface{}, len(v))
+		for i := range v {
+			c[i] = copyJSONValue(v[i])
+		}
+
+		return c
+	default:
+		return v
+	}
+}
+
+// jsonObject returns the object stored as key in m, replacing anything else
+// with a new empty object.
+func jsonObject(m map[string]interface{}, key string) map[string]interface{} {
+	o, ok := m[key].(map[string]interface{})
+	if !ok {
+		o = make(map[string]interface{})
+		m[key] = o
+	}
+
+	return o
+}
+
+// setJSON will set key in m to value, or remove key if empty is true.
+func setJSON(m map[string]interface{}, key string, value interface{}, empty bool) {
+	if empty {
+		delete(m, key)
+		return
+	}
+
+	m[key] = value
+}
+
+// Events will list events on or after from.
+func (c *Client) Events(from time.Time) ([]Event, error) {
+	if !c.authenticated() {
+		return nil, ErrNotAuthenticated
+	}
+
+	const pageSize = 20
+
+	var events []Event
+
+	for page := 1; ; page++ {
+		URL := fmt.Sprintf("https://connect.garmin.com/modern/proxy/calendar-service/events?startDate=%s&pageIndex=%d&limit=%d&sortOrder=eventDate_asc",
+			formatDate(from),
+			page,
+			pageSize)
+
+		var list []Event
+
+		err := c.getJSON(URL, &list)
+		if err != nil {
+			return nil, err
+		}
+
+		events = append(events, list...)
+
+		if len(list) < pageSize {
+			break
+		}
+	}
+
+	return events, nil
+}
+
+// Event will retrieve a single event.
+func (c *Client) Event(id int64) (*Event, error) {
+	URL := fmt.Sprintf("https://connect.garmin.com/modern/proxy/calendar-service/event/%d", id)
+
+	if !c.authenticated() {
+		return nil, ErrNotAuthenticated
+	}
+
+	event := new(Event)
+
+	err := c.getJSON(URL, event)
+	if err != nil {
+		return nil, err
+	}
+
+	return event, nil
+}
+
+// CreateEvent will add event to the event calendar. The event as stored by
+// Garmin Connect will be returned.
+func (c *Client) CreateEvent(event *Event) (*Event, error) {
+	URL := "https://connect.garmin.com/modern/proxy/calendar-service/event"
+
+	if !c.authenticated() {
+		return nil, ErrNotAuthenticated
+	}
+
+	created := new(Event)
+
+	err := c.writeJSON("POST", URL, event, created)
+	if err != nil {
+		return nil, err
+	}
+
+	return created, nil
+}
+
+// UpdateEvent will replace an existing event. event.ID must be set.
+func (c *Client) UpdateEvent(event *Event) error {
+	if event.ID == 0 {
+		return Error("event has no ID")
+	}
+
+	URL := fmt.Sprintf("https://connect.garmin.com/modern/proxy/calendar-service/event/%d", event.ID)
+
+	if !c.authenticated() {
+		return ErrNotAuthenticated
+	}
+
+	return c.write("PUT", URL, event, 200)
+}
+
+// DeleteEvent will delete an event.
+func (c *Client) DeleteEvent(id int64) error {
+	URL := fmt.Sprintf("https://connect.garmin.com/modern/proxy/calendar-service/event/%d", id)
+
+	if !c.authenticated() {
+		return ErrNotAuthenticated
+	}
+
+	return c.write("DELETE", URL, nil, 204)
+}
@@ -0,0 +1,104 @@
+package connect
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestEventRoundTrip(t *testing.T) {
+	in := `{"id":12,"eventName":"Marathon","date":"2020-10-04","eventType":"running","race":true,` +
+		`"completionTarget":{"value":26.2,"unit":"mile","unitType":"distance"},` +
+		`"eventCustomization":{"isPrimaryEvent":true,"isTrainingEvent":false,"enrollmentTime":1583020800000},` +
+		`"eventPrivacy":{"label":"PUBLIC","isShareable":true},"shareableEventUuid":"abc"}`
+
+	var event Event
+	err := json.Unmarshal([]byte(in), &event)
+	if err != nil {
+		t.Fatalf("Unmarshal() returned %s", err.Error())
+	}
+
+	if event.Distance < 42164.0 || event.Distance > 42165.0 {
+		t.Errorf("Expected distance of 42164.8m, got %f", event.Distance)
+	}
+
+	event.Name = "City Marathon"
+
+	out, err := json.Marshal(&event)
+	if err != nil {
+		t.Fatalf("Marshal() returned %s", err.Error())
+	}
+
+	var result struct {
+		Name   string `json:"eventName"`
+		Target struct {
+			Value float64 `json:"value"`
+			Unit  string  `json:"unit"`
+		} `json:"completionTarget"`
+		Customization struct {
+			Enrollment int64 `json:"enrollmentTime"`
+		} `json:"eventCustomization"`
+		Privacy struct {
+			Label string `json:"label"`
+		} `json:"eventPrivacy"`
+		UUID string `json:"shareableEventUuid"`
+	}
+
+	err = json.Unmarshal(out, &result)
+	if err != nil {
+		t.Fatalf("Unmarshal() returned %s", err.Error())
+	}
+
+	if result.Name != "City Marathon" {
+		t.Errorf("Expected name to be updated, got '%s'", result.Name)
+	}
+
+	if result.Target.Value != 26.2 || result.Target.Unit != "mile" {
+		t.Errorf("Expected distance of 26.2 mile to be kept, got %f %s", result.Target.Value, result.Target.Unit)
+	}
+
+	if result.Customization.Enrollment != 1583020800000 {
+		t.Errorf("Expected unknown customization to be kept, got %d", result.Customization.Enrollment)
+	}
+
+	if result.Privacy.Label != "PUBLIC" {
+		t.Errorf("Expected privacy to be kept, got '%s'", result.Privacy.Label)
+	}
+
+	if result.UUID != "abc" {
+		t.Errorf("Expected unknown field to be kept, got '%s'", result.UUID)
+	}
+}
+
+func TestEventNew(t *testing.T) {
+	event := &Event{
+		Name:     "10K",
+		Distance: 10000.0,
+		GoalTime: 45 * time.Minute,
+	}
+
+	out, err := json.Marshal(event)
+	if err != nil {
+		t.Fatalf("Marshal() returned %s", err.Error())
+	}
+
+	var result map[string]interface{}
+	err = json.Unmarshal(out, &result)
+	if err != nil {
+		t.Fatalf("Unmarshal() returned %s", err.Error())
+	}
+
+	if _, found := result["id"]; found {
+		t.Errorf("Expected no ID for a new event")
+	}
+
+	privacy, _ := result["eventPrivacy"].(map[string]interface{})
+	if privacy["label"] != "PRIVATE" {
+		t.Errorf("Expected new event to be private, got %v", privacy["label"])
+	}
+
+	target, _ := result["completionTarget"].(map[string]interface{})
+	if target["value"] != 10000.0 || target["unit"] != "meter" {
+		t.Errorf("Expected distance of 10000 meter, got %v", target)
+	}
+}
@@ -0,0 +1,192 @@
+package connect
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"time"
+	"unicode/utf8"
+)
+
+// fitBaseType is a base type as defined in the FIT protocol.
+type fitBaseType byte
+
+// The FIT base types used by this package.
+const (
+	fitEnum    fitBaseType = 0x00
+	fitSint8   fitBaseType = 0x01
+	fitUint8   fitBaseType = 0x02
+	fitString  fitBaseType = 0x07
+	fitSint16  fitBaseType = 0x83
+	fitUint16  fitBaseType = 0x84
+	fitSint32  fitBaseType = 0x85
+	fitUint32  fitBaseType = 0x86
+	fitUint32z fitBaseType = 0x8c
+)
+
+const (
+	// fitProfileVersion is the FIT profile version we claim to follow.
+	fitProfileVersion = 2100
+
+	// fitEpoch is the start of FIT timestamps (1989-12-31T00:00:00Z).
+	fitEpoch = 631065600
+)
+
+// size returns the size in bytes of numeric base types.
+func (t fitBaseType) size() int {
+	switch t {
+	case fitEnum, fitSint8, fitUint8:
+		return 1
+	case fitSint16, fitUint16:
+		return 2
+	default:
+		return 4
+	}
+}
+
+// fitField is a single field in a FIT message.
+type fitField struct {
+	num   byte
+	typ   fitBaseType
+	value int64
+	text  string
+	size  int
+}
+
+// fitValue returns a numeric field.
+func fitValue(num byte, typ fitBaseType, value int64) fitField {
+	return fitField{num: num, typ: typ, value: value, size: typ.size()}
+}
+
+// fitText returns a string field. The string will be truncated or padded
+// to size bytes including the terminating zero. Truncation will not split
+// UTF-8 runes.
+func fitText(num byte, text string, size int) fitField {
+	for len(text) > size-1 {
+		_, n := utf8.DecodeLastRuneInString(text)
+		text = text[:len(text)-n]
+	}
+
+	return fitField{num: num, typ: fitString, text: text, size: size}
+}
+
+// fitTime converts t to a FIT timestamp.
+func fitTime(t time.Time) int64 {
+	return t.Unix() - fitEpoch
+}
+
+// fitEncoder can encode messages to a FIT file.
+type fitEncoder struct {
+	data        bytes.Buffer
+	definitions map[string]byte
+	nextLocal   byte
+}
+
+// newFitEncoder returns a new encoder ready for messages.
+func newFitEncoder() *fitEncoder {
+	return &fitEncoder{
+		definitions: make(map[string]byte),
+	}
+}
+
+// message will add a message to the file. A definition message will be
+// written if needed.
+func (e *fitEncoder) message(global uint16, fields ...fitField) {
+	signature := fmt.Sprintf("%d", global)
+	for _, f := range fields {
+		signature += fmt.Sprintf(":%d/%d/%d", f.num, f.typ, f.size)
+	}
+
+	local, found := e.definitions[signature]
+	if !found {
+		// FIT supports 16 local message types. We reuse them round-robin.
+		local = e.nextLocal
+		e.nextLocal = (e.nextLocal + 1) % 16
+
+		for s, l := range e.definitions {
+			if l == local {
+				delete(e.definitions, s)
+			}
+		}
+		e.definitions[signature] = local
+
+		e.data.WriteByte(0x40 | local)
+		e.data.WriteByte(0)                                    // Reserved.
+		e.data.WriteByte(0)                                    // Little endian.
+		_ = binary.Write(&e.data, binary.LittleEndian, global) // Global message number.
+		e.data.WriteByte(byte(len(fields)))
+
+		for _, f := range fields {
+			e.data.Write([]byte{f.num, byte(f.size), byte(f.typ)})
+		}
+	}
+
+	e.data.WriteByte(local)
+
+	for _, f := range fields {
+		switch f.typ {
+		case fitString:
+			b := make([]byte, f.size)
+			copy(b[:f.size-1], f.text)
+			e.data.Write(b)
+		case fitEnum, fitSint8, fitUint8:
+			e.data.WriteByte(byte(f.value))
+		case fitSint16, fitUint16:
+			_ = binary.Write(&e.data, binary.LittleEndian, uint16(f.value))
+		default:
+			_ = binary.Write(&e.data, binary.LittleEndian, uint32(f.value))
+		}
+	}
+}
+
+// writeTo will write the complete FIT file including header and checksum
+// to w.
+func (e *fitEncoder) writeTo(w io.Writer) error {
+	header := make([]byte, 12, 14)
+	header[0] = 14
+	header[1] = 0x10 // Protocol version 1.0.
+	binary.LittleEndian.PutUint16(header[2:], fitProfileVersion)
+	binary.LittleEndian.PutUint32(header[4:], uint32(e.data.Len()))
+	copy(header[8:], ".FIT")
+
+	headerCRC := fitCRC(0, header)
+	header = append(header, byte(headerCRC), byte(headerCRC>>8))
+
+	crc := fitCRC(fitCRC(0, header), e.data.Bytes())
+
+	_, err := w.Write(header)
+	if err != nil {
+		return err
+	}
+
+	_, err = w.Write(e.data.Bytes())
+	if err != nil {
+		return err
+	}
+
+	_, err = w.Write([]byte{byte(crc), byte(crc >> 8)})
+
+	return err
+}
+
+// fitCRCTable is the lookup table used for FIT checksums.
+var fitCRCTable = [16]uint16{
+	0x0000, 0xcc01, 0xd801, 0x1400, 0xf001, 0x3c00, 0x2800, 0xe401,
+	0xa001, 0x6c00, 0x7800, 0xb401, 0x5000, 0x9c01, 0x8801, 0x4400,
+}
+
+// fitCRC will update crc with the bytes in data.
+func fitCRC(crc uint16, data []byte) uint16 {
+	for _, b := range data {
+		tmp := fitCRCTable[crc&0xf]
+		crc = (crc >> 4) & 0x0fff
+		crc = crc ^ tmp ^ fitCRCTable[b&0xf]
+
+		tmp = fitCRCTable[crc&0xf]
+		crc = (crc >> 4) & 0x0fff
+		crc = crc ^ tmp ^ fitCRCTable[(b>>4)&0xf]
+	}
+
+	return crc
+}
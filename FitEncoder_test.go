@@ -0,0 +1,49 @@
+package connect
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+	"time"
+)
+
+func TestFitEncoder(t *testing.T) {
+	b := BodyComposition{
+		Timestamp:         time.Date(2020, 1, 1, 7, 30, 0, 0, time.UTC),
+		Weight:            80500.0,
+		BodyFatPercentage: 18.5,
+	}
+
+	buffer := bytes.NewBuffer(nil)
+
+	err := b.writeFIT(buffer)
+	if err != nil {
+		t.Fatalf("Failed to write FIT file: %s", err.Error())
+	}
+
+	data := buffer.Bytes()
+
+	if len(data) < 16 || string(data[8:12]) != ".FIT" {
+		t.Fatalf("Missing FIT header")
+	}
+
+	size := binary.LittleEndian.Uint32(data[4:8])
+	if int(size) != len(data)-14-2 {
+		t.Errorf("Header claims %d bytes of data, got %d", size, len(data)-14-2)
+	}
+
+	// The checksum of a header including its checksum must be zero. Same
+	// goes for the complete file.
+	if fitCRC(0, data[:14]) != 0 {
+		t.Errorf("Header checksum mismatch")
+	}
+
+	if fitCRC(0, data) != 0 {
+		t.Errorf("File checksum mismatch")
+	}
+
+	// The weight should be stored in the last message as kg * 100.
+	if !bytes.Contains(data, []byte{0x72, 0x1f}) {
+		t.Errorf("Weight of 80.5 kg not found in file")
+	}
+}
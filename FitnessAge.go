@@ -0,0 +1,57 @@
+package connect
+
+import (
+	"fmt"
+	"time"
+)
+
+// FitnessAge is the age of the user as estimated from the users fitness.
+type FitnessAge struct {
+	Date                 Date    `json:"-"`
+	ChronologicalAge     int     `json:"chronologicalAge"`
+	FitnessAge           float64 `json:"fitnessAge"`
+	AchievableFitnessAge float64 `json:"achievableFitnessAge"`
+}
+
+// FitnessAge will retrieve the fitness age as of date.
+func (c *Client) FitnessAge(date time.Time) (*FitnessAge, error) {
+	URL := fmt.Sprintf("https://connect.garmin.com/modern/proxy/fitnessage-service/fitnessage/%s",
+		formatDate(date))
+
+	if !c.authenticated() {
+		return nil, ErrNotAuthenticated
+	}
+
+	age := new(FitnessAge)
+
+	err := c.getJSON(URL, age)
+	if err != nil {
+		return nil, err
+	}
+
+	age.Date = NewDate(date)
+
+	return age, nil
+}
+
+// FitnessAgeRange will retrieve the fitness age for all days between from
+// and to (both inclusive).
+func (c *Client) FitnessAgeRange(from time.Time, to time.Time) ([]FitnessAge, error) {
+	ages := make([]FitnessAge, numDays(from, to))
+
+	err := forEachDay(from, to, func(i int, date time.Time) error {
+		age, err := c.FitnessAge(date)
+		if err != nil {
+			return err
+		}
+
+		ages[i] = *age
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return ages, nil
+}
@@ -1,7 +1,10 @@
 package connect
 
 import (
+	"bytes"
+	"encoding/json"
 	"fmt"
+	"time"
 )
 
 // Gear describes a Garmin Connect gear entry
@@ -12,6 +15,7 @@ type Gear struct {
 	GearMakeName    string  `json:"gearMakeName"`
 	GearModelName   string  `json:"gearModelName"`
 	GearTypeName    string  `json:"gearTypeName"`
+	GearStatusName  string  `json:"gearStatusName"`
 	DisplayName     string  `json:"displayName"`
 	CustomMakeModel string  `json:"customMakeModel"`
 	ImageNameLarge  string  `json:"imageNameLarge"`
@@ -23,8 +27,43 @@ type Gear struct {
 	Notified        bool    `json:"notified"`
 	CreateDate      Time    `json:"createDate"`
 	UpdateDate      Time    `json:"updateDate"`
+
+	// raw is the gear as retrieved from Garmin Connect. It's used to write
+	// back fields not modelled by Gear unchanged.
+	raw map[string]interface{}
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (g *Gear) UnmarshalJSON(value []byte) error {
+	type gear Gear
+
+	var proxy gear
+
+	err := json.Unmarshal(value, &proxy)
+	if err != nil {
+		return err
+	}
+
+	// Numbers are kept as json.Number to be written back exactly.
+	dec := json.NewDecoder(bytes.NewReader(value))
+	dec.UseNumber()
+
+	err = dec.Decode(&proxy.raw)
+	if err != nil {
+		return err
+	}
+
+	*g = Gear(proxy)
+
+	return nil
 }
 
+// Known gear statuses.
+const (
+	GearStatusActive  = "active"
+	GearStatusRetired = "retired"
+)
+
 // GearType desribes the types of gear
 type GearType struct {
 	TypeID     int    `json:"gearTypePk"`
@@ -129,3 +168,107 @@ func (c *Client) GearForActivity(profileID int64, activityID int) ([]Gear, error
 
 	return gear, nil
 }
+
+// gearPayload converts gear to the format expected when writing. Fields
+// not modelled by Gear are written back as retrieved. Zero dates are sent
+// as null.
+func gearPayload(gear Gear) map[string]interface{} {
+	date := func(t Time) interface{} {
+		if t.IsZero() {
+			return nil
+		}
+
+		return t.Format("2006-01-02T15:04:05.0")
+	}
+
+	status := gear.GearStatusName
+	if status == "" {
+		status = GearStatusActive
+	}
+
+	m := copyJSONObject(gear.raw)
+
+	setJSON(m, "uuid", gear.Uuid, gear.Uuid == "")
+	setJSON(m, "gearPk", gear.GearPk, gear.GearPk == 0)
+	m["userProfilePk"] = gear.UserProfileID
+	setJSON(m, "gearMakeName", gear.GearMakeName, gear.GearMakeName == "")
+	setJSON(m, "gearModelName", gear.GearModelName, gear.GearModelName == "")
+	m["gearTypeName"] = gear.GearTypeName
+	m["gearStatusName"] = status
+	m["displayName"] = gear.DisplayName
+	setJSON(m, "customMakeModel", gear.CustomMakeModel, gear.CustomMakeModel == "")
+	m["dateBegin"] = date(gear.DateBegin)
+	m["dateEnd"] = date(gear.DateEnd)
+	m["maximumMeters"] = gear.MaximumMeters
+	m["notified"] = gear.Notified
+
+	return m
+}
+
+// CreateGear will add a new item of gear for the authenticated user.
+// GearTypeName and DisplayName are required. The gear as stored by Garmin
+// Connect will be returned.
+func (c *Client) CreateGear(gear Gear) (*Gear, error) {
+	URL := "https://connect.garmin.com/modern/proxy/gear-service/gear"
+
+	if !c.authenticated() || c.Profile == nil {
+		return nil, ErrNotAuthenticated
+	}
+
+	if gear.GearTypeName == "" || gear.DisplayName == "" {
+		return nil, Error("gear type and name is required")
+	}
+
+	gear.UserProfileID = c.Profile.ProfileID
+
+	if gear.DateBegin.IsZero() {
+		gear.DateBegin = Time{time.Now()}
+	}
+
+	created := new(Gear)
+
+	err := c.writeJSON("POST", URL, gearPayload(gear), created)
+	if err != nil {
+		return nil, err
+	}
+
+	return created, nil
+}
+
+// UpdateGear will replace an existing item of gear. gear.Uuid must be set.
+// Gear retrieved from Garmin Connect should be used, to keep the fields not
+// modelled by Gear.
+func (c *Client) UpdateGear(gear Gear) error {
+	if gear.Uuid == "" {
+		return Error("gear has no UUID")
+	}
+
+	URL := fmt.Sprintf("https://connect.garmin.com/modern/proxy/gear-service/gear/%s", gear.Uuid)
+
+	if !c.authenticated() {
+		return ErrNotAuthenticated
+	}
+
+	return c.write("PUT", URL, gearPayload(gear), 200)
+}
+
+// RetireGear will retire an item of gear as of date. Retired gear keeps
+// its history but can no longer be linked to new activities.
+func (c *Client) RetireGear(gear Gear, date time.Time) error {
+	gear.GearStatusName = GearStatusRetired
+	gear.DateEnd = Time{date}
+
+	return c.UpdateGear(gear)
+}
+
+// DeleteGear will permanently delete an item of gear. It will be unlinked
+// from all activities.
+func (c *Client) DeleteGear(uuid string) error {
+	URL := fmt.Sprintf("https://connect.garmin.com/modern/proxy/gear-service/gear/%s", uuid)
+
+	if !c.authenticated() {
+		return ErrNotAuthenticated
+	}
+
+	return c.write("DELETE", URL, nil, 204)
+}
@@ -0,0 +1,125 @@
+package connect
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// GearDefault tells that an item of gear is linked to new activities of an
+// activity type by default.
+type GearDefault struct {
+	GearUUID       string `json:"uuid"`
+	GearPk         int    `json:"gearPk"`
+	ActivityTypeID int    `json:"activityTypePk"`
+	Default        bool   `json:"defaultGear"`
+}
+
+// GearDefaults will list the default gear per activity type for the
+// authenticated user.
+func (c *Client) GearDefaults() ([]GearDefault, error) {
+	if !c.authenticated() || c.Profile == nil {
+		return nil, ErrNotAuthenticated
+	}
+
+	URL := fmt.Sprintf("https://connect.garmin.com/modern/proxy/gear-service/gear/user/%d/activityTypes",
+		c.Profile.ProfileID,
+	)
+
+	var all []GearDefault
+
+	err := c.getJSON(URL, &all)
+	if err != nil {
+		return nil, err
+	}
+
+	defaults := make([]GearDefault, 0, len(all))
+	for _, d := range all {
+		if d.Default {
+			defaults = append(defaults, d)
+		}
+	}
+
+	return defaults, nil
+}
+
+// SetGearDefault will make an item of gear the default for activityTypeID.
+func (c *Client) SetGearDefault(uuid string, activityTypeID int) error {
+	URL := fmt.Sprintf("https://connect.garmin.com/modern/proxy/gear-service/gear/%s/activityType/%d/default/true",
+		uuid,
+		activityTypeID,
+	)
+
+	if !c.authenticated() {
+		return ErrNotAuthenticated
+	}
+
+	return c.write("PUT", URL, nil, 0)
+}
+
+// RemoveGearDefault will stop an item of gear from being the default for
+// activityTypeID.
+func (c *Client) RemoveGearDefault(uuid string, activityTypeID int) error {
+	URL := fmt.Sprintf("https://connect.garmin.com/modern/proxy/gear-service/gear/%s/activityType/%d",
+		uuid,
+		activityTypeID,
+	)
+
+	if !c.authenticated() {
+		return ErrNotAuthenticated
+	}
+
+	return c.write("DELETE", URL, nil, 0)
+}
+
+// GearRule describes which activities an item of gear should be linked to.
+// Empty criteria always match.
+type GearRule struct {
+	GearUUID string
+
+	// ActivityType is matched against the type key, like "running".
+	ActivityType   string
+	ActivityTypeID int
+
+	// MinDistance and MaxDistance is in meters.
+	MinDistance float64
+	MaxDistance float64
+
+	Name *regexp.Regexp
+}
+
+// Matches returns true if activity matches all criteria of r.
+func (r *GearRule) Matches(activity *Activity) bool {
+	if r.ActivityType != "" && r.ActivityType != activity.ActivityType.TypeKey {
+		return false
+	}
+
+	if r.ActivityTypeID != 0 && r.ActivityTypeID != activity.ActivityType.TypeID {
+		return false
+	}
+
+	if r.MinDistance > 0.0 && activity.Distance < r.MinDistance {
+		return false
+	}
+
+	if r.MaxDistance > 0.0 && activity.Distance > r.MaxDistance {
+		return false
+	}
+
+	if r.Name != nil && !r.Name.MatchString(activity.ActivityName) {
+		return false
+	}
+
+	return true
+}
+
+// MatchGearRule returns the first rule matching activity or nil if none
+// match.
+func MatchGearRule(rules []GearRule, activity *Activity) *GearRule {
+	for i := range rules {
+		if rules[i].Matches(activity) {
+			return &rules[i]
+		}
+	}
+
+	return nil
+}
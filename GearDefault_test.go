@@ -0,0 +1,39 @@
+package connect
+
+import (
+	"regexp"
+	"testing"
+)
+
+func TestMatchGearRule(t *testing.T) {
+	rules := []GearRule{
+		{GearUUID: "trail", ActivityType: "running", Name: regexp.MustCompile("(?i)trail")},
+		{GearUUID: "racer", ActivityType: "running", MaxDistance: 10000.0},
+		{GearUUID: "trainer", ActivityType: "running"},
+		{GearUUID: "bike", ActivityTypeID: 2},
+	}
+
+	cases := []struct {
+		activity Activity
+		expected string
+	}{
+		{Activity{ActivityName: "Morning Trail Run", ActivityType: ActivityType{TypeKey: "running"}, Distance: 5000.0}, "trail"},
+		{Activity{ActivityName: "Tempo", ActivityType: ActivityType{TypeKey: "running"}, Distance: 8000.0}, "racer"},
+		{Activity{ActivityName: "Long run", ActivityType: ActivityType{TypeKey: "running"}, Distance: 25000.0}, "trainer"},
+		{Activity{ActivityName: "Commute", ActivityType: ActivityType{TypeID: 2, TypeKey: "cycling"}}, "bike"},
+		{Activity{ActivityName: "Swim", ActivityType: ActivityType{TypeID: 26, TypeKey: "lap_swimming"}}, ""},
+	}
+
+	for _, c := range cases {
+		rule := MatchGearRule(rules, &c.activity)
+
+		uuid := ""
+		if rule != nil {
+			uuid = rule.GearUUID
+		}
+
+		if uuid != c.expected {
+			t.Errorf("Expected '%s' for '%s', got '%s'", c.expected, c.activity.ActivityName, uuid)
+		}
+	}
+}
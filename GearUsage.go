@@ -0,0 +1,97 @@
+package connect
+
+import (
+	"fmt"
+	"time"
+)
+
+// GearUsage describes how much an item of gear is used and when it's
+// expected to reach its maximum distance.
+type GearUsage struct {
+	Gear            Gear
+	TotalDistance   float64 // meter
+	TotalActivities int
+
+	// UsedPercent is the percentage of Gear.MaximumMeters used. It's zero
+	// if the gear has no maximum.
+	UsedPercent float64
+
+	// WeeklyDistance is the average distance per week in the recent
+	// period.
+	WeeklyDistance float64
+
+	// Retirement is the projected date where the maximum distance will be
+	// reached at the recent weekly distance. It's zero if it cannot be
+	// projected.
+	Retirement time.Time
+}
+
+// GearActivities will list activities linked to an item of gear. The
+// newest activities are listed first.
+func (c *Client) GearActivities(uuid string, start int, limit int) ([]Activity, error) {
+	URL := fmt.Sprintf("https://connect.garmin.com/modern/proxy/activitylist-service/activities/%s/gear?start=%d&limit=%d",
+		uuid,
+		start,
+		limit,
+	)
+
+	if !c.authenticated() {
+		return nil, ErrNotAuthenticated
+	}
+
+	var activities []Activity
+
+	err := c.getJSON(URL, &activities)
+	if err != nil {
+		return nil, err
+	}
+
+	return activities, nil
+}
+
+// NewGearUsage will calculate usage of gear. activities should include at
+// least all activities with the gear in the last weeks before now, older
+// activities are ignored for the weekly distance.
+func NewGearUsage(gear Gear, stats GearStats, activities []Activity, weeks int, now time.Time) GearUsage {
+	usage := GearUsage{
+		Gear:            gear,
+		TotalDistance:   stats.TotalDistance,
+		TotalActivities: stats.TotalActivities,
+	}
+
+	if gear.MaximumMeters > 0.0 {
+		usage.UsedPercent = 100.0 * usage.TotalDistance / gear.MaximumMeters
+	}
+
+	if weeks < 1 {
+		return usage
+	}
+
+	cutoff := now.AddDate(0, 0, -7*weeks)
+
+	recent := 0.0
+	for _, a := range activities {
+		if a.StartLocal.Before(cutoff) || a.StartLocal.After(now) {
+			continue
+		}
+
+		recent += a.Distance
+	}
+
+	usage.WeeklyDistance = recent / float64(weeks)
+
+	if gear.MaximumMeters <= 0.0 {
+		return usage
+	}
+
+	remaining := gear.MaximumMeters - usage.TotalDistance
+	switch {
+	case remaining <= 0.0:
+		usage.Retirement = now
+	case usage.WeeklyDistance > 0.0:
+		weeksLeft := remaining / usage.WeeklyDistance
+		usage.Retirement = now.Add(time.Duration(weeksLeft * 7 * 24 * float64(time.Hour)))
+	}
+
+	return usage
+}
@@ -0,0 +1,54 @@
+package connect
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNewGearUsage(t *testing.T) {
+	now := time.Date(2020, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	gear := Gear{MaximumMeters: 800000.0}
+	stats := GearStats{TotalDistance: 600000.0, TotalActivities: 60}
+
+	var activities []Activity
+	for week := 0; week < 8; week++ {
+		activities = append(activities, Activity{
+			StartLocal: Time{now.AddDate(0, 0, -7*week-1)},
+			Distance:   25000.0,
+		})
+	}
+
+	// Too old to count.
+	activities = append(activities, Activity{
+		StartLocal: Time{now.AddDate(0, -6, 0)},
+		Distance:   100000.0,
+	})
+
+	usage := NewGearUsage(gear, stats, activities, 4, now)
+
+	if usage.UsedPercent != 75.0 {
+		t.Errorf("Expected 75%% used, got %f", usage.UsedPercent)
+	}
+
+	if usage.WeeklyDistance != 25000.0 {
+		t.Errorf("Expected 25 km per week, got %f", usage.WeeklyDistance)
+	}
+
+	// 200 km left at 25 km per week.
+	expected := now.AddDate(0, 0, 8*7)
+	if !usage.Retirement.Equal(expected) {
+		t.Errorf("Expected retirement at %s, got %s", expected, usage.Retirement)
+	}
+
+	stats.TotalDistance = 900000.0
+	usage = NewGearUsage(gear, stats, activities, 4, now)
+	if !usage.Retirement.Equal(now) {
+		t.Errorf("Expected worn out gear to retire now, got %s", usage.Retirement)
+	}
+
+	usage = NewGearUsage(Gear{}, stats, activities, 4, now)
+	if usage.UsedPercent != 0.0 || !usage.Retirement.IsZero() {
+		t.Errorf("Expected no projection without maximum, got %+v", usage)
+	}
+}
@@ -0,0 +1,43 @@
+package connect
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestGearPayload(t *testing.T) {
+	in := `{"uuid":"abc","gearPk":42,"gearTypeName":"Shoes","gearTypePk":1,"gearMakePk":17,` +
+		`"displayName":"Trainers","dateBegin":"2020-01-01T00:00:00.0","dateEnd":"2020-06-01T00:00:00.0"}`
+
+	var gear Gear
+	err := json.Unmarshal([]byte(in), &gear)
+	if err != nil {
+		t.Fatalf("Unmarshal() returned %s", err.Error())
+	}
+
+	gear.DisplayName = "Old trainers"
+	gear.DateEnd = Time{}
+
+	payload := gearPayload(gear)
+
+	if payload["displayName"] != "Old trainers" {
+		t.Errorf("Expected name to be updated, got %v", payload["displayName"])
+	}
+
+	if payload["gearMakePk"] != json.Number("17") || payload["gearTypePk"] != json.Number("1") {
+		t.Errorf("Expected unknown fields to be kept, got %v and %v", payload["gearMakePk"], payload["gearTypePk"])
+	}
+
+	if end, found := payload["dateEnd"]; !found || end != nil {
+		t.Errorf("Expected end date to be cleared, got %v", end)
+	}
+
+	if payload["dateBegin"] != "2020-01-01T00:00:00.0" {
+		t.Errorf("Expected begin date to be kept, got %v", payload["dateBegin"])
+	}
+
+	// The retrieved gear must not be changed.
+	if gear.raw["displayName"] != "Trainers" {
+		t.Errorf("Expected retrieved gear to be untouched, got %v", gear.raw["displayName"])
+	}
+}
@@ -19,20 +19,73 @@ type Goal struct {
 // GoalType represents different types of goals.
 type GoalType int
 
+// Known goal types.
+const (
+	GoalTypeSteps            GoalType = 0
+	GoalTypeActiveCalories   GoalType = 1
+	GoalTypeDistance         GoalType = 2
+	GoalTypeCaloriesConsumed GoalType = 3
+	GoalTypeWeight           GoalType = 4
+	GoalTypeBodyFat          GoalType = 5
+	GoalTypeSleep            GoalType = 6
+	GoalTypeFloors           GoalType = 7
+	GoalTypeIntensityMinutes GoalType = 8
+	GoalTypeHydration        GoalType = 9
+)
+
+// GoalTypes lists all known goal types.
+var GoalTypes = []GoalType{
+	GoalTypeSteps,
+	GoalTypeActiveCalories,
+	GoalTypeDistance,
+	GoalTypeCaloriesConsumed,
+	GoalTypeWeight,
+	GoalTypeBodyFat,
+	GoalTypeSleep,
+	GoalTypeFloors,
+	GoalTypeIntensityMinutes,
+	GoalTypeHydration,
+}
+
 // String implements Stringer.
 func (t GoalType) String() string {
 	switch t {
-	case 0:
+	case GoalTypeSteps:
 		return "steps-per-day"
-	case 4:
+	case GoalTypeActiveCalories:
+		return "active-calories"
+	case GoalTypeDistance:
+		return "distance"
+	case GoalTypeCaloriesConsumed:
+		return "calories-consumed"
+	case GoalTypeWeight:
 		return "weight"
-	case 7:
+	case GoalTypeBodyFat:
+		return "body-fat"
+	case GoalTypeSleep:
+		return "sleep-duration"
+	case GoalTypeFloors:
 		return "floors-ascended"
+	case GoalTypeIntensityMinutes:
+		return "intensity-minutes"
+	case GoalTypeHydration:
+		return "hydration"
 	default:
 		return fmt.Sprintf("unknown:%d", t)
 	}
 }
 
+// ParseGoalType will parse a goal type as returned by GoalType.String().
+func ParseGoalType(name string) (GoalType, error) {
+	for _, t := range GoalTypes {
+		if t.String() == name {
+			return t, nil
+		}
+	}
+
+	return 0, fmt.Errorf("unknown goal type '%s'", name)
+}
+
 // Goals lists all goals for displayName of type goalType. If displayName is
 // empty, the currently authenticated user will be used.
 func (c *Client) Goals(displayName string, goalType int) ([]Goal, error) {
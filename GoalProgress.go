@@ -0,0 +1,140 @@
+package connect
+
+import (
+	"sort"
+	"time"
+)
+
+// GoalDay is the outcome of a goal for a single day.
+type GoalDay struct {
+	Date  Date
+	Value float64
+	Met   bool
+}
+
+// GoalProgress summarizes how a goal has been met over a range of days.
+type GoalProgress struct {
+	Goal    Goal
+	Days    []GoalDay
+	Met     int
+	Streak  int
+	Longest int
+}
+
+// GoalValues returns the daily values from summaries relevant for goal type
+// t. Intensity minutes are counted like Garmin does, as moderate minutes plus
+// twice the vigorous minutes. The boolean will be false if summaries does not
+// carry values for t.
+func (t GoalType) GoalValues(summaries *DailySummaries) ([]DateValue, bool) {
+	switch t {
+	case GoalTypeSteps:
+		return summaries.TotalSteps, true
+	case GoalTypeActiveCalories:
+		return summaries.ActiveCalories, true
+	case GoalTypeDistance:
+		return summaries.Distance, true
+	case GoalTypeFloors:
+		return summaries.FloorsAscended, true
+	case GoalTypeIntensityMinutes:
+		values := make(map[Date]float64)
+		for _, v := range summaries.ModerateIntensityMinutes {
+			values[v.Date] += v.Value
+		}
+
+		for _, v := range summaries.VigorousIntensifyMinutes {
+			values[v.Date] += 2 * v.Value
+		}
+
+		ret := make([]DateValue, 0, len(values))
+		for date, value := range values {
+			ret = append(ret, DateValue{Date: date, Value: value})
+		}
+
+		return ret, true
+	default:
+		return nil, false
+	}
+}
+
+// SleepGoalValues returns the sleep of nights as values comparable to the
+// value of a sleep goal. The wellness goals API stores sleep goals in
+// seconds, like the rest of the sleep API. nights must hold a night per day
+// starting at from, like the ones returned by Client.SleepRange().
+func SleepGoalValues(from time.Time, nights []SleepSummary) []DateValue {
+	values := make([]DateValue, len(nights))
+	for i, n := range nights {
+		values[i] = DateValue{
+			Date:  NewDate(from.AddDate(0, 0, i)),
+			Value: n.Sleep.Seconds(),
+		}
+	}
+
+	return values
+}
+
+// NewGoalProgress computes the progress of goal from daily values, like
+// the ones returned by GoalType.GoalValues(). Days outside the period of the
+// goal are ignored. Intensity minutes are a weekly goal, so each day is
+// compared against the sum since the start of the week, weekStart being the
+// first day of the week. The current streak ends with the latest day, but
+// since the latest day may still be in progress, it's allowed to be unmet.
+func NewGoalProgress(goal Goal, values []DateValue, weekStart time.Weekday) *GoalProgress {
+	sorted := make([]DateValue, len(values))
+	copy(sorted, values)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Date.Time().Before(sorted[j].Date.Time())
+	})
+
+	weekly := goal.GoalType == GoalTypeIntensityMinutes
+
+	p := &GoalProgress{
+		Goal: goal,
+	}
+
+	start := goal.Start.Time()
+	end := goal.End.Time()
+	hasEnd := goal.End != Date{}
+
+	run := 0
+	for i, v := range sorted {
+		date := v.Date.Time()
+		if date.Before(start) || (hasEnd && date.After(end)) {
+			continue
+		}
+
+		value := v.Value
+		if weekly {
+			first := date.AddDate(0, 0, -((int(date.Weekday()) - int(weekStart) + 7) % 7))
+			for j := i - 1; j >= 0 && !sorted[j].Date.Time().Before(first); j-- {
+				value += sorted[j].Value
+			}
+		}
+
+		met := value >= float64(goal.Value)
+		p.Days = append(p.Days, GoalDay{
+			Date:  v.Date,
+			Value: value,
+			Met:   met,
+		})
+
+		if met {
+			p.Met++
+			run++
+			if run > p.Longest {
+				p.Longest = run
+			}
+		} else {
+			run = 0
+		}
+	}
+
+	for i := len(p.Days) - 1; i >= 0; i-- {
+		if p.Days[i].Met {
+			p.Streak++
+		} else if i < len(p.Days)-1 {
+			break
+		}
+	}
+
+	return p
+}
@@ -0,0 +1,136 @@
+package connect
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNewGoalProgress(t *testing.T) {
+	goal := Goal{
+		GoalType: GoalTypeSteps,
+		Start:    Date{2020, 6, 2},
+		Value:    10000,
+	}
+
+	values := []DateValue{
+		{Date{2020, 6, 7}, 2000},
+		{Date{2020, 6, 1}, 20000},
+		{Date{2020, 6, 2}, 12000},
+		{Date{2020, 6, 3}, 11000},
+		{Date{2020, 6, 4}, 15000},
+		{Date{2020, 6, 5}, 9000},
+		{Date{2020, 6, 6}, 10000},
+	}
+
+	p := NewGoalProgress(goal, values, time.Monday)
+
+	if len(p.Days) != 6 {
+		t.Errorf("Expected 6 days, got %d", len(p.Days))
+	}
+
+	if p.Met != 4 {
+		t.Errorf("Expected goal met 4 days, got %d", p.Met)
+	}
+
+	// The unmet last day may still be in progress.
+	if p.Streak != 1 {
+		t.Errorf("Expected streak of 1, got %d", p.Streak)
+	}
+
+	if p.Longest != 3 {
+		t.Errorf("Expected longest streak of 3, got %d", p.Longest)
+	}
+}
+
+func TestNewGoalProgressIntensityMinutes(t *testing.T) {
+	goal := Goal{
+		GoalType: GoalTypeIntensityMinutes,
+		Start:    Date{2020, 5, 31},
+		Value:    150,
+	}
+
+	// 2020-06-01 is a Monday.
+	summaries := &DailySummaries{
+		ModerateIntensityMinutes: []DateValue{
+			{Date{2020, 5, 31}, 120},
+			{Date{2020, 6, 1}, 60},
+			{Date{2020, 6, 2}, 0},
+			{Date{2020, 6, 3}, 30},
+		},
+		VigorousIntensifyMinutes: []DateValue{
+			{Date{2020, 5, 31}, 20},
+			{Date{2020, 6, 2}, 20},
+			{Date{2020, 6, 3}, 0},
+		},
+	}
+
+	values, ok := GoalTypeIntensityMinutes.GoalValues(summaries)
+	if !ok {
+		t.Fatalf("Expected intensity minutes to be tracked by daily summaries")
+	}
+
+	p := NewGoalProgress(goal, values, time.Monday)
+
+	// The week restarts on Monday.
+	expected := []float64{160, 60, 100, 130}
+	if len(p.Days) != len(expected) {
+		t.Fatalf("Expected %d days, got %d", len(expected), len(p.Days))
+	}
+
+	for i, v := range expected {
+		if p.Days[i].Value != v {
+			t.Errorf("Expected %.0f minutes on day %d, got %.0f", v, i, p.Days[i].Value)
+		}
+	}
+
+	if p.Met != 1 {
+		t.Errorf("Expected goal met 1 day, got %d", p.Met)
+	}
+
+	p = NewGoalProgress(goal, values, time.Sunday)
+	if p.Days[3].Value != 290 {
+		t.Errorf("Expected 290 minutes with weeks starting Sunday, got %.0f", p.Days[3].Value)
+	}
+}
+
+func TestGoalValuesUnsupported(t *testing.T) {
+	_, ok := GoalTypeWeight.GoalValues(&DailySummaries{})
+	if ok {
+		t.Errorf("Expected weight not to be tracked by daily summaries")
+	}
+}
+
+func TestNewGoalProgressSleep(t *testing.T) {
+	goal := Goal{
+		GoalType: GoalTypeSleep,
+		Start:    Date{2020, 6, 1},
+		Value:    8 * 60 * 60,
+	}
+
+	nights := []SleepSummary{
+		{Sleep: 8*time.Hour + 30*time.Minute},
+		{Sleep: 7*time.Hour + 59*time.Minute},
+		{Sleep: 8 * time.Hour},
+	}
+
+	from := time.Date(2020, 6, 1, 0, 0, 0, 0, time.UTC)
+	values := SleepGoalValues(from, nights)
+
+	if values[2].Date != (Date{2020, 6, 3}) {
+		t.Errorf("Expected last night on 2020-06-03, got %s", values[2].Date)
+	}
+
+	if values[0].Value != 30600 {
+		t.Errorf("Expected 30600 seconds, got %f", values[0].Value)
+	}
+
+	p := NewGoalProgress(goal, values, time.Monday)
+
+	if p.Met != 2 {
+		t.Errorf("Expected goal met 2 nights, got %d", p.Met)
+	}
+
+	if p.Longest != 1 {
+		t.Errorf("Expected longest streak of 1, got %d", p.Longest)
+	}
+}
@@ -0,0 +1,82 @@
+package connect
+
+import (
+	"fmt"
+	"time"
+)
+
+// HRVBaseline is the personal heart-rate variability baseline. All values
+// are in milliseconds.
+type HRVBaseline struct {
+	LowUpper      int     `json:"lowUpper"`
+	BalancedLow   int     `json:"balancedLow"`
+	BalancedUpper int     `json:"balancedUpper"`
+	MarkerValue   float64 `json:"markerValue"`
+}
+
+// HRVStatus is the overnight heart-rate variability status for a single
+// night. All values are in milliseconds.
+type HRVStatus struct {
+	Date          Date        `json:"calendarDate"`
+	WeeklyAverage int         `json:"weeklyAvg"`
+	LastNight     int         `json:"lastNightAvg"`
+	LastNightHigh int         `json:"lastNight5MinHigh"`
+	Baseline      HRVBaseline `json:"baseline"`
+	Status        string      `json:"status"`
+	Feedback      string      `json:"feedbackPhrase"`
+}
+
+// HRVReading is a single heart-rate variability reading. Value is in
+// milliseconds.
+type HRVReading struct {
+	Value     int  `json:"hrvValue"`
+	Timestamp Time `json:"readingTimeGMT"`
+	Local     Time `json:"readingTimeLocal"`
+}
+
+// HRVStatus will retrieve the HRV status and the individual readings for
+// the night ending on date.
+func (c *Client) HRVStatus(date time.Time) (*HRVStatus, []HRVReading, error) {
+	URL := fmt.Sprintf("https://connect.garmin.com/modern/proxy/hrv-service/hrv/%s",
+		formatDate(date))
+
+	if !c.authenticated() {
+		return nil, nil, ErrNotAuthenticated
+	}
+
+	var proxy struct {
+		Summary  HRVStatus    `json:"hrvSummary"`
+		Readings []HRVReading `json:"hrvReadings"`
+	}
+
+	err := c.getJSON(URL, &proxy)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &proxy.Summary, proxy.Readings, nil
+}
+
+// HRVStatusRange will retrieve the HRV status for all nights between from
+// and to (both inclusive).
+func (c *Client) HRVStatusRange(from time.Time, to time.Time) ([]HRVStatus, error) {
+	URL := fmt.Sprintf("https://connect.garmin.com/modern/proxy/hrv-service/hrv/daily/%s/%s",
+		formatDate(from),
+		formatDate(to),
+	)
+
+	if !c.authenticated() {
+		return nil, ErrNotAuthenticated
+	}
+
+	var proxy struct {
+		Summaries []HRVStatus `json:"hrvSummaries"`
+	}
+
+	err := c.getJSON(URL, &proxy)
+	if err != nil {
+		return nil, err
+	}
+
+	return proxy.Summaries, nil
+}
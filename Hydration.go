@@ -0,0 +1,85 @@
+package connect
+
+import (
+	"fmt"
+	"time"
+)
+
+// Hydration is the fluid intake for a single day.
+type Hydration struct {
+	Date           Date    `json:"calendarDate"`
+	Intake         float64 `json:"valueInML"`          // ml
+	Goal           float64 `json:"goalInML"`           // ml
+	DailyAverage   float64 `json:"dailyAverageinML"`   // ml
+	SweatLoss      float64 `json:"sweatLossInML"`      // ml
+	ActivityIntake float64 `json:"activityIntakeInML"` // ml
+	LastEntry      Time    `json:"lastEntryTimestampLocal"`
+}
+
+// Hydration will retrieve the hydration summary for date.
+func (c *Client) Hydration(date time.Time) (*Hydration, error) {
+	URL := fmt.Sprintf("https://connect.garmin.com/modern/proxy/usersummary-service/usersummary/hydration/daily/%s",
+		formatDate(date))
+
+	if !c.authenticated() {
+		return nil, ErrNotAuthenticated
+	}
+
+	hydration := new(Hydration)
+
+	err := c.getJSON(URL, hydration)
+	if err != nil {
+		return nil, err
+	}
+
+	// Days without any data is returned without a date.
+	hydration.Date = NewDate(date)
+
+	return hydration, nil
+}
+
+// HydrationRange will retrieve the hydration summary for all days between
+// from and to (both inclusive).
+func (c *Client) HydrationRange(from time.Time, to time.Time) ([]Hydration, error) {
+	days := make([]Hydration, numDays(from, to))
+
+	err := forEachDay(from, to, func(i int, date time.Time) error {
+		hydration, err := c.Hydration(date)
+		if err != nil {
+			return err
+		}
+
+		days[i] = *hydration
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return days, nil
+}
+
+// LogHydration will log fluid intake at timestamp. intake is in ml, and can
+// be negative to correct an earlier entry.
+func (c *Client) LogHydration(timestamp time.Time, intake float64) error {
+	URL := "https://connect.garmin.com/modern/proxy/usersummary-service/usersummary/hydration/log"
+
+	if !c.authenticated() || c.Profile == nil {
+		return ErrNotAuthenticated
+	}
+
+	payload := struct {
+		Date          Date    `json:"calendarDate"`
+		Timestamp     string  `json:"timestampLocal"`
+		Intake        float64 `json:"valueInML"`
+		UserProfileID int64   `json:"userProfileId"`
+	}{
+		Date:          NewDate(timestamp),
+		Timestamp:     timestamp.Format("2006-01-02T15:04:05.000"),
+		Intake:        intake,
+		UserProfileID: c.Profile.ProfileID,
+	}
+
+	return c.write("PUT", URL, payload, 200)
+}
@@ -0,0 +1,129 @@
+package connect
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// WriteICS will write items as an iCalendar (RFC 5545) feed to w. Items
+// with a start time will use floating local time, items without will be
+// all-day events.
+func WriteICS(w io.Writer, items []CalendarItem) error {
+	return writeICS(w, items, time.Now())
+}
+
+// writeICS is WriteICS with a fixed timestamp for DTSTAMP.
+func writeICS(w io.Writer, items []CalendarItem, now time.Time) error {
+	b := bufio.NewWriter(w)
+	stamp := now.UTC().Format("20060102T150405Z")
+
+	line := func(name string, value string) {
+		writeICSLine(b, name+":"+value)
+	}
+
+	line("BEGIN", "VCALENDAR")
+	line("VERSION", "2.0")
+	line("PRODID", "-//abrander//garmin-connect//EN")
+	line("CALSCALE", "GREGORIAN")
+	line("X-WR-CALNAME", "Garmin Connect")
+
+	for _, item := range items {
+		itemType := item.ItemType
+		if itemType == "" {
+			itemType = "item"
+		}
+
+		line("BEGIN", "VEVENT")
+		line("UID", fmt.Sprintf("%s-%d@connect.garmin.com", itemType, item.ID))
+		line("DTSTAMP", stamp)
+
+		start := item.StartTimestampLocal.Time
+		if start.IsZero() {
+			date := item.Date.Time()
+			line("DTSTART;VALUE=DATE", date.Format("20060102"))
+			line("DTEND;VALUE=DATE", date.AddDate(0, 0, 1).Format("20060102"))
+		} else {
+			line("DTSTART", start.Format("20060102T150405"))
+			if item.Duration > 0 {
+				line("DURATION", fmt.Sprintf("PT%dS", item.Duration))
+			}
+		}
+
+		summary := item.Title
+		if summary == "" {
+			summary = itemType
+		}
+		line("SUMMARY", escapeICS(summary))
+
+		var description []string
+		if item.Distance > 0 {
+			description = append(description, fmt.Sprintf("Distance: %.2f km", float64(item.Distance)/1000.0))
+		}
+		if item.Duration > 0 {
+			description = append(description, fmt.Sprintf("Duration: %s", time.Duration(item.Duration)*time.Second))
+		}
+		if item.Calories > 0 {
+			description = append(description, fmt.Sprintf("Calories: %d kcal", item.Calories))
+		}
+		if len(description) > 0 {
+			line("DESCRIPTION", escapeICS(strings.Join(description, "\n")))
+		}
+
+		line("CATEGORIES", escapeICS(itemType))
+		line("END", "VEVENT")
+	}
+
+	line("END", "VCALENDAR")
+
+	return b.Flush()
+}
+
+// escapeICS will escape text as required for TEXT values.
+func escapeICS(text string) string {
+	r := strings.NewReplacer(
+		`\`, `\\`,
+		";", `\;`,
+		",", `\,`,
+		"\r\n", `\n`,
+		"\n", `\n`,
+	)
+
+	return r.Replace(text)
+}
+
+// writeICSLine will write a content line terminated by CRLF. Lines longer
+// than 75 octets are folded without splitting UTF-8 sequences.
+func writeICSLine(w *bufio.Writer, line string) {
+	const max = 75
+
+	first := true
+	for len(line) > 0 {
+		limit := max
+		if !first {
+			// Room for the leading space.
+			limit--
+		}
+
+		n := len(line)
+		if n > limit {
+			n = limit
+			for n > 0 && !utf8.RuneStart(line[n]) {
+				n--
+			}
+		}
+
+		if !first {
+			w.WriteByte(' ')
+		}
+
+		w.WriteString(line[:n])
+		w.WriteString("\r\n")
+
+		line = line[n:]
+		first = false
+	}
+}
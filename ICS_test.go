@@ -0,0 +1,74 @@
+package connect
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestWriteICS(t *testing.T) {
+	items := []CalendarItem{
+		{
+			ID:                  42,
+			ItemType:            "activity",
+			Title:               "Morning run, easy; with \"strides\"",
+			Date:                Date{2020, 3, 1},
+			StartTimestampLocal: Time{time.Date(2020, 3, 1, 7, 30, 0, 0, time.UTC)},
+			Duration:            3600,
+			Distance:            10000,
+			Calories:            700,
+		},
+		{
+			ID:       43,
+			ItemType: "workout",
+			Title:    strings.Repeat("æ", 60),
+			Date:     Date{2020, 3, 2},
+		},
+	}
+
+	buffer := bytes.NewBuffer(nil)
+
+	err := writeICS(buffer, items, time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatalf("Failed to write ICS: %s", err.Error())
+	}
+
+	ics := buffer.String()
+
+	expected := []string{
+		"BEGIN:VCALENDAR\r\n",
+		"UID:activity-42@connect.garmin.com\r\n",
+		"DTSTAMP:20200101T000000Z\r\n",
+		"DTSTART:20200301T073000\r\n",
+		"DURATION:PT3600S\r\n",
+		"SUMMARY:Morning run\\, easy\\; with \"strides\"\r\n",
+		"DESCRIPTION:Distance: 10.00 km\\nDuration: 1h0m0s\\nCalories: 700 kcal\r\n",
+		"UID:workout-43@connect.garmin.com\r\n",
+		"DTSTART;VALUE=DATE:20200302\r\n",
+		"DTEND;VALUE=DATE:20200303\r\n",
+		"END:VCALENDAR\r\n",
+	}
+
+	for _, e := range expected {
+		if !strings.Contains(ics, e) {
+			t.Errorf("Expected ICS to contain %q", e)
+		}
+	}
+
+	for _, line := range strings.Split(strings.TrimSuffix(ics, "\r\n"), "\r\n") {
+		if len(line) > 75 {
+			t.Errorf("Line longer than 75 octets: %q", line)
+		}
+
+		if !strings.HasPrefix(line, " ") && !strings.Contains(line, ":") {
+			t.Errorf("Malformed line: %q", line)
+		}
+	}
+
+	// Unfolding must restore the long summary.
+	unfolded := strings.Replace(ics, "\r\n ", "", -1)
+	if !strings.Contains(unfolded, "SUMMARY:"+strings.Repeat("æ", 60)+"\r\n") {
+		t.Errorf("Folded summary not restored by unfolding")
+	}
+}
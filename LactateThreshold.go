@@ -0,0 +1,103 @@
+package connect
+
+import (
+	"fmt"
+	"sort"
+	"time"
+)
+
+// LactateThreshold is the lactate threshold as detected by a device. Speed
+// is in meters per second.
+type LactateThreshold struct {
+	Date      Date    `json:"calendarDate"`
+	HeartRate int     `json:"heartRate"`
+	Speed     float64 `json:"speed"`
+}
+
+// LatestLactateThreshold will retrieve the latest lactate threshold for the
+// authenticated user.
+func (c *Client) LatestLactateThreshold() (*LactateThreshold, error) {
+	URL := "https://connect.garmin.com/modern/proxy/biometric-service/biometric/latestLactateThreshold"
+
+	if !c.authenticated() {
+		return nil, ErrNotAuthenticated
+	}
+
+	var proxy struct {
+		SpeedAndHeartRate LactateThreshold `json:"speed_and_heart_rate"`
+	}
+
+	err := c.getJSON(URL, &proxy)
+	if err != nil {
+		return nil, err
+	}
+
+	return &proxy.SpeedAndHeartRate, nil
+}
+
+// LactateThresholdRange will retrieve the lactate thresholds detected
+// between from and to (both inclusive).
+func (c *Client) LactateThresholdRange(from time.Time, to time.Time) ([]LactateThreshold, error) {
+	if !c.authenticated() {
+		return nil, ErrNotAuthenticated
+	}
+
+	type stat struct {
+		From  Date    `json:"from"`
+		Value float64 `json:"value"`
+	}
+
+	get := func(metric string) ([]stat, error) {
+		URL := fmt.Sprintf("https://connect.garmin.com/modern/proxy/biometric-service/stats/%s/range/%s/%s?sport=RUNNING&aggregation=daily&aggregationStrategy=LATEST",
+			metric,
+			formatDate(from),
+			formatDate(to),
+		)
+
+		var stats []stat
+
+		err := c.getJSON(URL, &stats)
+
+		return stats, err
+	}
+
+	heartRates, err := get("lactateThresholdHeartRate")
+	if err != nil {
+		return nil, err
+	}
+
+	speeds, err := get("lactateThresholdSpeed")
+	if err != nil {
+		return nil, err
+	}
+
+	byDate := make(map[Date]*LactateThreshold)
+	lookup := func(date Date) *LactateThreshold {
+		threshold, found := byDate[date]
+		if !found {
+			threshold = &LactateThreshold{Date: date}
+			byDate[date] = threshold
+		}
+
+		return threshold
+	}
+
+	for _, s := range heartRates {
+		lookup(s.From).HeartRate = int(s.Value)
+	}
+
+	for _, s := range speeds {
+		lookup(s.From).Speed = s.Value
+	}
+
+	thresholds := make([]LactateThreshold, 0, len(byDate))
+	for _, threshold := range byDate {
+		thresholds = append(thresholds, *threshold)
+	}
+
+	sort.Slice(thresholds, func(i, j int) bool {
+		return thresholds[i].Date.Time().Before(thresholds[j].Date.Time())
+	})
+
+	return thresholds, nil
+}
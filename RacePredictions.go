@@ -0,0 +1,94 @@
+package connect
+
+import (
+	"fmt"
+	"time"
+)
+
+// RacePredictions is the predicted race times for a user on a given date.
+type RacePredictions struct {
+	Date         Date
+	FiveK        time.Duration
+	TenK         time.Duration
+	HalfMarathon time.Duration
+	Marathon     time.Duration
+}
+
+// racePredictionsProxy is used to deserialize race predictions from
+// seconds to proper Go types.
+type racePredictionsProxy struct {
+	Date         Date    `json:"calendarDate"`
+	FiveK        float64 `json:"time5K"`
+	TenK         float64 `json:"time10K"`
+	HalfMarathon float64 `json:"timeHalfMarathon"`
+	Marathon     float64 `json:"timeMarathon"`
+}
+
+func (p *racePredictionsProxy) predictions() RacePredictions {
+	return RacePredictions{
+		Date:         p.Date,
+		FiveK:        time.Duration(p.FiveK * float64(time.Second)),
+		TenK:         time.Duration(p.TenK * float64(time.Second)),
+		HalfMarathon: time.Duration(p.HalfMarathon * float64(time.Second)),
+		Marathon:     time.Duration(p.Marathon * float64(time.Second)),
+	}
+}
+
+// RacePredictions will retrieve the latest race predictions for
+// displayName. If displayName is empty, the currently authenticated user
+// will be used.
+func (c *Client) RacePredictions(displayName string) (*RacePredictions, error) {
+	if displayName == "" && c.Profile == nil {
+		return nil, ErrNotAuthenticated
+	}
+
+	if displayName == "" && c.Profile != nil {
+		displayName = c.Profile.DisplayName
+	}
+
+	URL := "https://connect.garmin.com/modern/proxy/metrics-service/metrics/racepredictions/latest/" + displayName
+
+	var proxy racePredictionsProxy
+
+	err := c.getJSON(URL, &proxy)
+	if err != nil {
+		return nil, err
+	}
+
+	predictions := proxy.predictions()
+
+	return &predictions, nil
+}
+
+// RacePredictionsRange will retrieve the daily race predictions between
+// from and to (both inclusive). If displayName is empty, the currently
+// authenticated user will be used.
+func (c *Client) RacePredictionsRange(displayName string, from time.Time, to time.Time) ([]RacePredictions, error) {
+	if displayName == "" && c.Profile == nil {
+		return nil, ErrNotAuthenticated
+	}
+
+	if displayName == "" && c.Profile != nil {
+		displayName = c.Profile.DisplayName
+	}
+
+	URL := fmt.Sprintf("https://connect.garmin.com/modern/proxy/metrics-service/metrics/racepredictions/daily/%s?fromCalendarDate=%s&toCalendarDate=%s",
+		displayName,
+		formatDate(from),
+		formatDate(to),
+	)
+
+	var proxy []racePredictionsProxy
+
+	err := c.getJSON(URL, &proxy)
+	if err != nil {
+		return nil, err
+	}
+
+	predictions := make([]RacePredictions, len(proxy))
+	for i := range proxy {
+		predictions[i] = proxy[i].predictions()
+	}
+
+	return predictions, nil
+}
@@ -0,0 +1,169 @@
+package connect
+
+import (
+	"fmt"
+	"math"
+	"time"
+)
+
+// BoundingBox is a geographic area given by latitudes and longitudes.
+type BoundingBox struct {
+	South float64
+	West  float64
+	North float64
+	East  float64
+}
+
+// BoundingBoxAround returns the bounding box of a circle with radius meters
+// around a coordinate.
+func BoundingBoxAround(lat float64, lon float64, radius float64) BoundingBox {
+	const earthRadius = 6371000.0
+
+	dLat := radius / earthRadius * 180.0 / math.Pi
+	dLon := dLat / math.Cos(lat*math.Pi/180.0)
+
+	return BoundingBox{
+		South: lat - dLat,
+		West:  lon - dLon,
+		North: lat + dLat,
+		East:  lon + dLon,
+	}
+}
+
+// SegmentPoint is a point on a segment.
+type SegmentPoint struct {
+	Lat      float64 `json:"lat"`
+	Lon      float64 `json:"lon"`
+	Altitude float64 `json:"altitude"`
+}
+
+// Segment is a stretch of road or trail where efforts are compared.
+type Segment struct {
+	ID            int64          `json:"segmentId"`
+	Name          string         `json:"name"`
+	ActivityType  ActivityType   `json:"activityType"`
+	Distance      float64        `json:"distance"`      // meter
+	ElevationGain float64        `json:"elevationGain"` // meter
+	AverageGrade  float64        `json:"avgGrade"`      // percent
+	Starred       bool           `json:"favorite"`
+	Points        []SegmentPoint `json:"polyline"`
+}
+
+// SegmentEffort is a single effort on a segment.
+type SegmentEffort struct {
+	Rank             int           `json:"rank"`
+	ActivityID       int64         `json:"activityId"`
+	DisplayName      string        `json:"displayName"`
+	FullName         string        `json:"fullName"`
+	Start            Time          `json:"startTimeLocal"`
+	ElapsedTime      time.Duration `json:"-"`
+	AverageHeartRate float64       `json:"averageHR"`
+}
+
+// SegmentsInBounds will search for segments inside box.
+func (c *Client) SegmentsInBounds(box BoundingBox) ([]Segment, error) {
+	URL := fmt.Sprintf("https://connect.garmin.com/modern/proxy/segment-service/segment/bounds?swLat=%f&swLon=%f&neLat=%f&neLon=%f",
+		box.South,
+		box.West,
+		box.North,
+		box.East)
+
+	if !c.authenticated() {
+		return nil, ErrNotAuthenticated
+	}
+
+	var segments []Segment
+
+	err := c.getJSON(URL, &segments)
+	if err != nil {
+		return nil, err
+	}
+
+	return segments, nil
+}
+
+// SegmentsNear will search for segments within radius meters of a
+// coordinate.
+func (c *Client) SegmentsNear(lat float64, lon float64, radius float64) ([]Segment, error) {
+	return c.SegmentsInBounds(BoundingBoxAround(lat, lon, radius))
+}
+
+// Segment will retrieve a segment including its geometry.
+func (c *Client) Segment(id int64) (*Segment, error) {
+	URL := fmt.Sprintf("https://connect.garmin.com/modern/proxy/segment-service/segment/%d", id)
+
+	if !c.authenticated() {
+		return nil, ErrNotAuthenticated
+	}
+
+	segment := new(Segment)
+
+	err := c.getJSON(URL, segment)
+	if err != nil {
+		return nil, err
+	}
+
+	return segment, nil
+}
+
+// SegmentLeaderboard will retrieve the fastest efforts on a segment.
+func (c *Client) SegmentLeaderboard(id int64, start int, limit int) ([]SegmentEffort, error) {
+	URL := fmt.Sprintf("https://connect.garmin.com/modern/proxy/segment-service/segment/%d/leaderboard?start=%d&limit=%d",
+		id,
+		start,
+		limit)
+
+	return c.segmentEfforts(URL)
+}
+
+// SegmentEfforts will list the efforts of the authenticated user on a
+// segment.
+func (c *Client) SegmentEfforts(id int64) ([]SegmentEffort, error) {
+	URL := fmt.Sprintf("https://connect.garmin.com/modern/proxy/segment-service/segment/%d/efforts", id)
+
+	return c.segmentEfforts(URL)
+}
+
+func (c *Client) segmentEfforts(URL string) ([]SegmentEffort, error) {
+	if !c.authenticated() {
+		return nil, ErrNotAuthenticated
+	}
+
+	var proxy []struct {
+		SegmentEffort
+		ElapsedTime float64 `json:"elapsedTime"` // seconds
+	}
+
+	err := c.getJSON(URL, &proxy)
+	if err != nil {
+		return nil, err
+	}
+
+	efforts := make([]SegmentEffort, len(proxy))
+	for i, p := range proxy {
+		efforts[i] = p.SegmentEffort
+		efforts[i].ElapsedTime = time.Duration(p.ElapsedTime * float64(time.Second))
+	}
+
+	return efforts, nil
+}
+
+// StarSegment will star a segment for the authenticated user.
+func (c *Client) StarSegment(id int64) error {
+	return c.starSegment("POST", id)
+}
+
+// UnstarSegment will remove the star from a segment.
+func (c *Client) UnstarSegment(id int64) error {
+	return c.starSegment("DELETE", id)
+}
+
+func (c *Client) starSegment(method string, id int64) error {
+	URL := fmt.Sprintf("https://connect.garmin.com/modern/proxy/segment-service/segment/%d/favorite", id)
+
+	if !c.authenticated() {
+		return ErrNotAuthenticated
+	}
+
+	return c.write(method, URL, nil, 0)
+}
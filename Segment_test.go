@@ -0,0 +1,24 @@
+package connect
+
+import (
+	"math"
+	"testing"
+)
+
+func TestBoundingBoxAround(t *testing.T) {
+	box := BoundingBoxAround(60.0, 10.0, 1000.0)
+
+	// One degree of latitude is roughly 111 km.
+	if math.Abs((box.North-box.South)-2.0/111.2) > 0.0001 {
+		t.Errorf("Wrong latitude span %f", box.North-box.South)
+	}
+
+	// At 60° a degree of longitude is half as long.
+	if math.Abs((box.East-box.West)-2.0*(box.North-box.South)) > 0.0001 {
+		t.Errorf("Wrong longitude span %f", box.East-box.West)
+	}
+
+	if box.South >= 60.0 || box.North <= 60.0 || box.West >= 10.0 || box.East <= 10.0 {
+		t.Errorf("Coordinate not inside box: %+v", box)
+	}
+}
@@ -0,0 +1,300 @@
+package connect
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"time"
+)
+
+// Sample is a measured value at a point in time.
+type Sample struct {
+	Timestamp time.Time
+	Value     float64
+}
+
+// Series is a time series of samples ordered by time.
+type Series []Sample
+
+// Min returns the lowest value in the series.
+func (s Series) Min() float64 {
+	if len(s) == 0 {
+		return 0.0
+	}
+
+	min := s[0].Value
+	for _, sample := range s {
+		if sample.Value < min {
+			min = sample.Value
+		}
+	}
+
+	return min
+}
+
+// Max returns the highest value in the series.
+func (s Series) Max() float64 {
+	max := 0.0
+	for i, sample := range s {
+		if i == 0 || sample.Value > max {
+			max = sample.Value
+		}
+	}
+
+	return max
+}
+
+// Average returns the average value of all samples in the series.
+func (s Series) Average() float64 {
+	if len(s) == 0 {
+		return 0.0
+	}
+
+	sum := 0.0
+	for _, sample := range s {
+		sum += sample.Value
+	}
+
+	return sum / float64(len(s))
+}
+
+// Between returns the samples from (inclusive) until to (exclusive).
+func (s Series) Between(from time.Time, to time.Time) Series {
+	between := make(Series, 0, len(s))
+	for _, sample := range s {
+		if !sample.Timestamp.Before(from) && sample.Timestamp.Before(to) {
+			between = append(between, sample)
+		}
+	}
+
+	return between
+}
+
+// Resample will average the series into n buckets of step duration starting
+// at start. Buckets without samples will be NaN.
+func (s Series) Resample(start time.Time, step time.Duration, n int) []float64 {
+	sums := make([]float64, n)
+	counts := make([]int, n)
+
+	for _, sample := range s {
+		offset := sample.Timestamp.Sub(start)
+		if offset < 0 {
+			continue
+		}
+
+		i := int(offset / step)
+		if i >= n {
+			continue
+		}
+
+		sums[i] += sample.Value
+		counts[i]++
+	}
+
+	for i := range sums {
+		if counts[i] == 0 {
+			sums[i] = math.NaN()
+			continue
+		}
+
+		sums[i] /= float64(counts[i])
+	}
+
+	return sums
+}
+
+// localZone returns a fixed time zone matching the difference between a
+// GMT and local timestamp from Garmin Connect.
+func localZone(gmt Time, local Time) *time.Location {
+	if gmt.IsZero() || local.IsZero() {
+		return time.UTC
+	}
+
+	offset := local.Sub(gmt.Time).Round(time.Minute)
+
+	return time.FixedZone("", int(offset.Seconds()))
+}
+
+// parseSeries will parse the [[timestamp, ..., value]] arrays used by Garmin
+// Connect. Timestamps are milliseconds since epoch. Samples without a
+// value or with a negative value are skipped.
+func parseSeries(values [][]interface{}, valueIndex int, location *time.Location) Series {
+	series := make(Series, 0, len(values))
+
+	for _, v := range values {
+		if len(v) <= valueIndex {
+			continue
+		}
+
+		timestamp, ok := v[0].(float64)
+		if !ok {
+			continue
+		}
+
+		value, ok := v[valueIndex].(float64)
+		if !ok || value < 0.0 {
+			continue
+		}
+
+		series = append(series, Sample{
+			Timestamp: time.Unix(int64(timestamp)/1000, 0).In(location),
+			Value:     value,
+		})
+	}
+
+	return series
+}
+
+// intraday will retrieve URL and parse the array found at key as a series.
+func (c *Client) intraday(URL string, key string) (Series, error) {
+	if !c.authenticated() {
+		return nil, ErrNotAuthenticated
+	}
+
+	var proxy map[string]json.RawMessage
+
+	err := c.getJSON(URL, &proxy)
+	if err != nil {
+		return nil, err
+	}
+
+	var start struct {
+		GMT   Time
+		Local Time
+	}
+
+	// The timestamps are missing for days without data.
+	_ = json.Unmarshal(proxy["startTimestampGMT"], &start.GMT)
+	_ = json.Unmarshal(proxy["startTimestampLocal"], &start.Local)
+
+	var values [][]interface{}
+
+	raw, found := proxy[key]
+	if found {
+		err = json.Unmarshal(raw, &values)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return parseSeries(values, 1, localZone(start.GMT, start.Local)), nil
+}
+
+// intradayRange will call fn for every day between from and to and return
+// the combined series.
+func intradayRange(from time.Time, to time.Time, fn func(date time.Time) (Series, error)) (Series, error) {
+	days := make([]Series, numDays(from, to))
+
+	err := forEachDay(from, to, func(i int, date time.Time) error {
+		var err error
+
+		days[i], err = fn(date)
+
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	series := make(Series, 0, len(days)*100)
+	for _, day := range days {
+		series = append(series, day...)
+	}
+
+	return series, nil
+}
+
+// IntradayHeartRate will retrieve the heart rate measured during date.
+func (c *Client) IntradayHeartRate(date time.Time) (Series, error) {
+	if c.Profile == nil {
+		return nil, ErrNotAuthenticated
+	}
+
+	URL := fmt.Sprintf("https://connect.garmin.com/modern/proxy/wellness-service/wellness/dailyHeartRate/%s?date=%s",
+		c.Profile.DisplayName,
+		formatDate(date),
+	)
+
+	return c.intraday(URL, "heartRateValues")
+}
+
+// IntradayHeartRateRange will retrieve the heart rate for all days between
+// from and to (both inclusive).
+func (c *Client) IntradayHeartRateRange(from time.Time, to time.Time) (Series, error) {
+	return intradayRange(from, to, c.IntradayHeartRate)
+}
+
+// IntradaySteps will retrieve the steps taken during date. Each sample is
+// the number of steps in the 15 minutes starting at Timestamp.
+func (c *Client) IntradaySteps(date time.Time) (Series, error) {
+	if c.Profile == nil {
+		return nil, ErrNotAuthenticated
+	}
+
+	URL := fmt.Sprintf("https://connect.garmin.com/modern/proxy/wellness-service/wellness/dailySummaryChart/%s?date=%s",
+		c.Profile.DisplayName,
+		formatDate(date),
+	)
+
+	var proxy []struct {
+		Start Time    `json:"startGMT"`
+		Steps float64 `json:"steps"`
+	}
+
+	err := c.getJSON(URL, &proxy)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(proxy) == 0 {
+		return Series{}, nil
+	}
+
+	// The first bucket starts at local midnight, we use that to find the
+	// local time zone.
+	location := localZone(proxy[0].Start, Time{NewDate(date).Time()})
+
+	series := make(Series, len(proxy))
+	for i, p := range proxy {
+		series[i].Timestamp = p.Start.In(location)
+		series[i].Value = p.Steps
+	}
+
+	return series, nil
+}
+
+// IntradayStepsRange will retrieve the steps for all days between from and
+// to (both inclusive).
+func (c *Client) IntradayStepsRange(from time.Time, to time.Time) (Series, error) {
+	return intradayRange(from, to, c.IntradaySteps)
+}
+
+// IntradayRespiration will retrieve the respiration rate in breaths per
+// minute measured during date.
+func (c *Client) IntradayRespiration(date time.Time) (Series, error) {
+	URL := fmt.Sprintf("https://connect.garmin.com/modern/proxy/wellness-service/wellness/daily/respiration/%s",
+		formatDate(date))
+
+	return c.intraday(URL, "respirationValuesArray")
+}
+
+// IntradayRespirationRange will retrieve the respiration rate for all days
+// between from and to (both inclusive).
+func (c *Client) IntradayRespirationRange(from time.Time, to time.Time) (Series, error) {
+	return intradayRange(from, to, c.IntradayRespiration)
+}
+
+// IntradayPulseOx will retrieve the hourly averages of blood oxygen
+// saturation (SpO2) in percent measured during date.
+func (c *Client) IntradayPulseOx(date time.Time) (Series, error) {
+	URL := fmt.Sprintf("https://connect.garmin.com/modern/proxy/wellness-service/wellness/daily/spo2/%s",
+		formatDate(date))
+
+	return c.intraday(URL, "spO2HourlyAverages")
+}
+
+// IntradayPulseOxRange will retrieve the blood oxygen saturation for all
+// days between from and to (both inclusive).
+func (c *Client) IntradayPulseOxRange(from time.Time, to time.Time) (Series, error) {
+	return intradayRange(from, to, c.IntradayPulseOx)
+}
@@ -0,0 +1,132 @@
+package connect
+
+import (
+	"math"
+	"time"
+)
+
+// SleepAggregate is aggregated sleep statistics for multiple nights.
+type SleepAggregate struct {
+	Nights int
+
+	AverageSleep time.Duration
+	AverageDeep  time.Duration
+	AverageLight time.Duration
+	AverageREM   time.Duration
+	AverageAwake time.Duration
+	AverageScore float64
+
+	// The stage percentages are relative to the time asleep.
+	DeepPercentage  float64
+	LightPercentage float64
+	REMPercentage   float64
+
+	// Bedtime and wake time are the local time of day, measured from
+	// midnight. Bedtime can exceed 24 hours if the user usually goes to bed
+	// after midnight.
+	AverageBedtime    time.Duration
+	BedtimeDeviation  time.Duration
+	AverageWakeTime   time.Duration
+	WakeTimeDeviation time.Duration
+}
+
+// AggregateSleep will aggregate sleep statistics for summaries. Nights
+// without any recorded sleep are ignored.
+func AggregateSleep(summaries []SleepSummary) SleepAggregate {
+	var aggregate SleepAggregate
+
+	var sleep, deep, light, rem, awake time.Duration
+	var score float64
+	var scored int
+
+	bedtimes := make([]float64, 0, len(summaries))
+	waketimes := make([]float64, 0, len(summaries))
+
+	for _, s := range summaries {
+		if s.Sleep <= 0 {
+			continue
+		}
+
+		aggregate.Nights++
+
+		sleep += s.Sleep
+		deep += s.Deep
+		light += s.Light
+		rem += s.REM
+		awake += s.Awake
+
+		if s.Scores.Overall.Value > 0 {
+			score += float64(s.Scores.Overall.Value)
+			scored++
+		}
+
+		// Bedtime is measured from noon the day before to avoid wrapping
+		// at midnight.
+		bedtime := timeOfDay(s.StartLocal.Time)
+		if bedtime < 12*time.Hour {
+			bedtime += 24 * time.Hour
+		}
+
+		bedtimes = append(bedtimes, bedtime.Minutes())
+		waketimes = append(waketimes, timeOfDay(s.EndLocal.Time).Minutes())
+	}
+
+	if aggregate.Nights == 0 {
+		return aggregate
+	}
+
+	nights := time.Duration(aggregate.Nights)
+
+	aggregate.AverageSleep = sleep / nights
+	aggregate.AverageDeep = deep / nights
+	aggregate.AverageLight = light / nights
+	aggregate.AverageREM = rem / nights
+	aggregate.AverageAwake = awake / nights
+
+	if scored > 0 {
+		aggregate.AverageScore = score / float64(scored)
+	}
+
+	aggregate.DeepPercentage = 100.0 * deep.Seconds() / sleep.Seconds()
+	aggregate.LightPercentage = 100.0 * light.Seconds() / sleep.Seconds()
+	aggregate.REMPercentage = 100.0 * rem.Seconds() / sleep.Seconds()
+
+	mean, deviation := meanAndDeviation(bedtimes)
+	aggregate.AverageBedtime = time.Duration(mean * float64(time.Minute))
+	aggregate.BedtimeDeviation = time.Duration(deviation * float64(time.Minute))
+
+	mean, deviation = meanAndDeviation(waketimes)
+	aggregate.AverageWakeTime = time.Duration(mean * float64(time.Minute))
+	aggregate.WakeTimeDeviation = time.Duration(deviation * float64(time.Minute))
+
+	return aggregate
+}
+
+// timeOfDay returns the duration since midnight for t.
+func timeOfDay(t time.Time) time.Duration {
+	return time.Duration(t.Hour())*time.Hour +
+		time.Duration(t.Minute())*time.Minute +
+		time.Duration(t.Second())*time.Second
+}
+
+// meanAndDeviation returns the mean and population standard deviation of
+// values.
+func meanAndDeviation(values []float64) (float64, float64) {
+	if len(values) == 0 {
+		return 0.0, 0.0
+	}
+
+	mean := 0.0
+	for _, v := range values {
+		mean += v
+	}
+	mean /= float64(len(values))
+
+	variance := 0.0
+	for _, v := range values {
+		variance += (v - mean) * (v - mean)
+	}
+	variance /= float64(len(values))
+
+	return mean, math.Sqrt(variance)
+}
@@ -0,0 +1,48 @@
+package connect
+
+import (
+	"testing"
+	"time"
+)
+
+func TestAggregateSleepBedtime(t *testing.T) {
+	night := func(start string, end string) SleepSummary {
+		s, _ := time.Parse("2006-01-02 15:04", start)
+		e, _ := time.Parse("2006-01-02 15:04", end)
+
+		return SleepSummary{
+			Sleep:      e.Sub(s),
+			Deep:       e.Sub(s) / 4,
+			StartLocal: Time{s},
+			EndLocal:   Time{e},
+		}
+	}
+
+	summaries := []SleepSummary{
+		night("2020-01-01 23:30", "2020-01-02 07:00"),
+		night("2020-01-03 00:30", "2020-01-03 07:00"),
+		{}, // A night without sleep should be ignored.
+	}
+
+	aggregate := AggregateSleep(summaries)
+
+	if aggregate.Nights != 2 {
+		t.Fatalf("Expected 2 nights, got %d", aggregate.Nights)
+	}
+
+	if aggregate.AverageBedtime != 24*time.Hour {
+		t.Errorf("Expected average bedtime at midnight, got %s", aggregate.AverageBedtime)
+	}
+
+	if aggregate.BedtimeDeviation != 30*time.Minute {
+		t.Errorf("Expected bedtime deviation of 30m, got %s", aggregate.BedtimeDeviation)
+	}
+
+	if aggregate.AverageWakeTime != 7*time.Hour {
+		t.Errorf("Expected average wake time at 07:00, got %s", aggregate.AverageWakeTime)
+	}
+
+	if aggregate.DeepPercentage != 25.0 {
+		t.Errorf("Expected 25%% deep sleep, got %f", aggregate.DeepPercentage)
+	}
+}
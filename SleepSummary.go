@@ -29,6 +29,45 @@ type SleepSummary struct {
 	Awake            time.Duration `json:"awakeSleepSeconds"`
 	DeviceRemCapable bool          `json:"deviceRemCapable"`
 	REMData          bool          `json:"remData"`
+
+	Scores             SleepScores `json:"sleepScores"`
+	ScoreFeedback      string      `json:"sleepScoreFeedback"`
+	ScoreInsight       string      `json:"sleepScoreInsight"`
+	Need               SleepNeed   `json:"sleepNeed"`
+	AverageRespiration float64     `json:"averageRespirationValue"`
+	LowestRespiration  float64     `json:"lowestRespirationValue"`
+	HighestRespiration float64     `json:"highestRespirationValue"`
+	AverageSpO2        float64     `json:"averageSpO2Value"`
+	LowestSpO2         float64     `json:"lowestSpO2Value"`
+	AverageStress      float64     `json:"avgSleepStress"`
+	AverageHRV         float64     `json:"-"`
+	HRVStatus          string      `json:"-"`
+}
+
+// SleepScore is a score between 0 and 100 with a qualifier like "GOOD" or
+// "FAIR".
+type SleepScore struct {
+	Value     int    `json:"value"`
+	Qualifier string `json:"qualifierKey"`
+}
+
+// SleepScores is the overall sleep score and the sub-scores it's based on.
+type SleepScores struct {
+	Overall         SleepScore `json:"overall"`
+	TotalDuration   SleepScore `json:"totalDuration"`
+	Stress          SleepScore `json:"stress"`
+	AwakeCount      SleepScore `json:"awakeCount"`
+	REMPercentage   SleepScore `json:"remPercentage"`
+	Restlessness    SleepScore `json:"restlessness"`
+	LightPercentage SleepScore `json:"lightPercentage"`
+	DeepPercentage  SleepScore `json:"deepPercentage"`
+}
+
+// SleepNeed is the amount of sleep needed as estimated by Garmin.
+type SleepNeed struct {
+	Actual   time.Duration `json:"actual"`
+	Baseline time.Duration `json:"baseline"`
+	Feedback string        `json:"feedback"`
 }
 
 // SleepMovement denotes the amount of movement for a short time period
@@ -67,6 +106,8 @@ func (c *Client) SleepData(displayName string, date time.Time) (*SleepSummary, [
 		REMData      bool            `json:"remSleepData"`
 		Movement     []SleepMovement `json:"sleepMovement"`
 		Levels       []SleepLevel    `json:"sleepLevels"`
+		AverageHRV   float64         `json:"avgOvernightHrv"`
+		HRVStatus    string          `json:"hrvStatus"`
 	}
 
 	err := c.getJSON(URL, &proxy)
@@ -83,7 +124,36 @@ func (c *Client) SleepData(displayName string, date time.Time) (*SleepSummary, [
 	proxy.SleepSummary.REM *= time.Second
 	proxy.SleepSummary.Awake *= time.Second
 
+	// ... except sleep need which is in minutes.
+	proxy.SleepSummary.Need.Actual *= time.Minute
+	proxy.SleepSummary.Need.Baseline *= time.Minute
+
 	proxy.SleepSummary.REMData = proxy.REMData
+	proxy.SleepSummary.AverageHRV = proxy.AverageHRV
+	proxy.SleepSummary.HRVStatus = proxy.HRVStatus
 
 	return &proxy.SleepSummary, proxy.Movement, proxy.Levels, nil
 }
+
+// SleepRange will retrieve the sleep summaries for all nights between from
+// and to (both inclusive). If displayName is empty, the currently
+// authenticated user will be used.
+func (c *Client) SleepRange(displayName string, from time.Time, to time.Time) ([]SleepSummary, error) {
+	summaries := make([]SleepSummary, numDays(from, to))
+
+	err := forEachDay(from, to, func(i int, date time.Time) error {
+		summary, _, _, err := c.SleepData(displayName, date)
+		if err != nil {
+			return err
+		}
+
+		summaries[i] = *summary
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return summaries, nil
+}
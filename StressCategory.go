@@ -0,0 +1,92 @@
+package connect
+
+import (
+	"time"
+)
+
+// StressCategory is the category of a stress level as used by Garmin
+// Connect.
+type StressCategory int
+
+// Known stress categories.
+const (
+	StressUnmeasured StressCategory = iota
+	StressActivity
+	StressRest
+	StressLow
+	StressMedium
+	StressHigh
+)
+
+// StressCategories lists all stress categories in order.
+var StressCategories = []StressCategory{
+	StressRest,
+	StressLow,
+	StressMedium,
+	StressHigh,
+	StressActivity,
+	StressUnmeasured,
+}
+
+// String implements Stringer.
+func (c StressCategory) String() string {
+	m := map[StressCategory]string{
+		StressUnmeasured: "unmeasured",
+		StressActivity:   "activity",
+		StressRest:       "rest",
+		StressLow:        "low",
+		StressMedium:     "medium",
+		StressHigh:       "high",
+	}
+
+	str, found := m[c]
+	if !found {
+		str = m[StressUnmeasured]
+	}
+
+	return str
+}
+
+// StressCategoryOf returns the category of a stress level. Garmin uses -1
+// for periods with too much activity and -2 for periods where stress could
+// not be measured.
+func StressCategoryOf(value int) StressCategory {
+	switch {
+	case value == -1:
+		return StressActivity
+	case value < 0:
+		return StressUnmeasured
+	case value <= 25:
+		return StressRest
+	case value <= 50:
+		return StressLow
+	case value <= 75:
+		return StressMedium
+	default:
+		return StressHigh
+	}
+}
+
+// Category returns the stress category for the point.
+func (p StressPoint) Category() StressCategory {
+	return StressCategoryOf(p.Value)
+}
+
+// Durations returns the time spent in each stress category. Each point is
+// assumed to last until the next point.
+func (d *DailyStress) Durations() map[StressCategory]time.Duration {
+	durations := make(map[StressCategory]time.Duration)
+
+	// Garmin measures stress every three minutes.
+	interval := 3 * time.Minute
+
+	for i, point := range d.Values {
+		if i+1 < len(d.Values) {
+			interval = d.Values[i+1].Timestamp.Sub(point.Timestamp)
+		}
+
+		durations[point.Category()] += interval
+	}
+
+	return durations
+}
@@ -0,0 +1,137 @@
+package connect
+
+import (
+	"math"
+	"time"
+)
+
+const (
+	// FitnessTimeConstant is the number of days used for the exponentially
+	// weighted chronic training load (fitness).
+	FitnessTimeConstant = 42
+
+	// FatigueTimeConstant is the number of days used for the exponentially
+	// weighted acute training load (fatigue).
+	FatigueTimeConstant = 7
+)
+
+// TrainingLoad is the training stress for a single day along with the
+// fitness/fatigue model derived from all preceding days.
+type TrainingLoad struct {
+	Date Date
+
+	// Load is the summed training impulse (TRIMP) of all activities on Date.
+	Load float64
+
+	// Fitness is the chronic training load (CTL).
+	Fitness float64
+
+	// Fatigue is the acute training load (ATL).
+	Fatigue float64
+
+	// Form is the training stress balance (TSB). It's calculated from the
+	// fitness and fatigue of the previous day, as is customary.
+	Form float64
+
+	// WorkloadRatio is the acute:chronic workload ratio (ACWR). It will be
+	// zero until some fitness has been built.
+	WorkloadRatio float64
+}
+
+// ZoneTRIMP calculates Edwards' training impulse from the time spent in each
+// heart-rate zone. Each minute is weighted by the zone number.
+func ZoneTRIMP(zones []ActivityHrZones) float64 {
+	trimp := 0.0
+
+	for _, zone := range zones {
+		if zone.ZoneNumber < 1 {
+			continue
+		}
+
+		trimp += zone.TimeInZone.Minutes() * float64(zone.ZoneNumber)
+	}
+
+	return trimp
+}
+
+// BanisterTRIMP calculates Banister's training impulse from a heart-rate
+// stream. restingHR and maxHR is used to calculate the heart-rate reserve.
+// Gaps longer than a minute between two samples are considered pauses and
+// will not count.
+func BanisterTRIMP(points Series, restingHR int, maxHR int, female bool) float64 {
+	if maxHR <= restingHR {
+		return 0.0
+	}
+
+	a, b := 0.64, 1.92
+	if female {
+		a, b = 0.86, 1.67
+	}
+
+	trimp := 0.0
+
+	for i := 1; i < len(points); i++ {
+		dt := points[i].Timestamp.Sub(points[i-1].Timestamp)
+		if dt <= 0 || dt > time.Minute {
+			continue
+		}
+
+		reserve := (points[i-1].Value - float64(restingHR)) / float64(maxHR-restingHR)
+		reserve = math.Max(0.0, math.Min(1.0, reserve))
+
+		trimp += dt.Minutes() * reserve * a * math.Exp(b*reserve)
+	}
+
+	return trimp
+}
+
+// TrainingLoads will calculate a fitness/fatigue series for every day
+// between from and to (both inclusive). loads is the training impulse per
+// day, multiple values for the same date will be summed. Loads before from
+// are used to build up the model, so callers should include at least
+// FitnessTimeConstant days before from to get meaningful results.
+func TrainingLoads(loads []DateValue, from time.Time, to time.Time) []TrainingLoad {
+	daily := make(map[Date]float64)
+
+	first := NewDate(from).Time()
+	last := NewDate(to).Time()
+
+	start := first
+	for _, l := range loads {
+		daily[l.Date] += l.Value
+
+		if l.Date.Time().Before(start) {
+			start = l.Date.Time()
+		}
+	}
+
+	var fitness, fatigue float64
+
+	result := make([]TrainingLoad, 0, numDays(first, last))
+
+	for day := NewDate(start); !day.Time().After(last); day = NewDate(day.Time().AddDate(0, 0, 1)) {
+		load := TrainingLoad{
+			Date: day,
+			Load: daily[day],
+			Form: fitness - fatigue,
+		}
+
+		fitness += (load.Load - fitness) / FitnessTimeConstant
+		fatigue += (load.Load - fatigue) / FatigueTimeConstant
+
+		load.Fitness = fitness
+		load.Fatigue = fatigue
+
+		if fitness > 0.0 {
+			load.WorkloadRatio = fatigue / fitness
+		}
+
+		if day.Time().Before(first) {
+			continue
+		}
+
+		result = append(result, load)
+	}
+
+	return result
+}
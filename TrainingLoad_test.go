@@ -0,0 +1,63 @@
+package connect
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestZoneTRIMP(t *testing.T) {
+	zones := []ActivityHrZones{
+		{TimeInZone: 10 * time.Minute, ZoneNumber: 1},
+		{TimeInZone: 20 * time.Minute, ZoneNumber: 2},
+		{TimeInZone: 5 * time.Minute, ZoneNumber: 4},
+	}
+
+	trimp := ZoneTRIMP(zones)
+	if trimp != 70.0 {
+		t.Errorf("Expected TRIMP 70.0, got %f", trimp)
+	}
+}
+
+func TestTrainingLoads(t *testing.T) {
+	from := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2020, 1, 3, 0, 0, 0, 0, time.UTC)
+
+	loads := []DateValue{
+		{Date: Date{2019, 12, 31}, Value: 42.0},
+		{Date: Date{2020, 1, 2}, Value: 50.0},
+		{Date: Date{2020, 1, 2}, Value: 20.0},
+	}
+
+	series := TrainingLoads(loads, from, to)
+	if len(series) != 3 {
+		t.Fatalf("Expected 3 days, got %d", len(series))
+	}
+
+	if series[0].Date != (Date{2020, 1, 1}) {
+		t.Errorf("Expected series to start at 2020-01-01, got %s", series[0].Date)
+	}
+
+	if series[1].Load != 70.0 {
+		t.Errorf("Expected load 70.0 on 2020-01-02, got %f", series[1].Load)
+	}
+
+	// The load from 2019-12-31 must be included in the model.
+	if math.Abs(series[0].Form-(1.0-6.0)) > 0.000001 {
+		t.Errorf("Expected form -5.0 on 2020-01-01, got %f", series[0].Form)
+	}
+
+	if series[2].WorkloadRatio <= 1.0 {
+		t.Errorf("Expected acute load to exceed chronic load, got ratio %f", series[2].WorkloadRatio)
+	}
+}
+
+func TestTrainingLoadsInverted(t *testing.T) {
+	from := time.Date(2020, 1, 3, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	series := TrainingLoads(nil, from, to)
+	if len(series) != 0 {
+		t.Errorf("Expected no days for an inverted range, got %d", len(series))
+	}
+}
@@ -0,0 +1,215 @@
+package connect
+
+import (
+	"fmt"
+	"time"
+)
+
+// TrainingPlanWorkout is a workout from a training plan.
+type TrainingPlanWorkout struct {
+	ID                int64            `json:"workoutId"`
+	UUID              string           `json:"workoutUuid"`
+	Name              string           `json:"workoutName"`
+	Description       string           `json:"description"`
+	SportType         WorkoutSportType `json:"sportType"`
+	EstimatedDuration int              `json:"estimatedDurationInSecs"`
+	RestDay           bool             `json:"restDay"`
+}
+
+// TrainingPlanTask is a workout scheduled by a training plan.
+type TrainingPlanTask struct {
+	Date    Date                `json:"calendarDate"`
+	Week    int                 `json:"weekId"`
+	Workout TrainingPlanWorkout `json:"taskWorkout"`
+}
+
+// TrainingPlan is a training plan like the ones from Garmin Coach.
+type TrainingPlan struct {
+	ID          int64  `json:"trainingPlanId"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Start       Date   `json:"startDate"`
+	End         Date   `json:"endDate"`
+	Weeks       int    `json:"durationInWeeks"`
+	Type        struct {
+		Key string `json:"typeKey"`
+	} `json:"trainingType"`
+	Level struct {
+		Key string `json:"levelKey"`
+	} `json:"trainingLevel"`
+
+	// Tasks are only included by TrainingPlan().
+	Tasks []TrainingPlanTask `json:"taskList"`
+}
+
+// TrainingPlanDay is a task from a training plan along with the activities
+// done that day.
+type TrainingPlanDay struct {
+	Task       TrainingPlanTask
+	Activities []CalendarItem
+	Completed  bool
+}
+
+// TrainingPlanAdherence describes how well a training plan has been
+// followed.
+type TrainingPlanAdherence struct {
+	Days []TrainingPlanDay
+
+	// Scheduled is the number of workouts scheduled until now.
+	Scheduled int
+
+	// Completed is the number of scheduled workouts with an activity.
+	Completed int
+
+	// Missed is the number of workouts scheduled before today without an
+	// activity.
+	Missed int
+}
+
+// TrainingPlans will list the training plans the authenticated user is
+// enrolled in.
+func (c *Client) TrainingPlans() ([]TrainingPlan, error) {
+	return c.trainingPlans("https://connect.garmin.com/modern/proxy/trainingplan-service/trainingplan/plans")
+}
+
+// AvailableTrainingPlans will list all training plans available for
+// enrollment.
+func (c *Client) AvailableTrainingPlans() ([]TrainingPlan, error) {
+	return c.trainingPlans("https://connect.garmin.com/modern/proxy/trainingplan-service/trainingplan/search?start=0&limit=1000")
+}
+
+func (c *Client) trainingPlans(URL string) ([]TrainingPlan, error) {
+	if !c.authenticated() {
+		return nil, ErrNotAuthenticated
+	}
+
+	var proxy struct {
+		Plans []TrainingPlan `json:"trainingPlanList"`
+	}
+
+	err := c.getJSON(URL, &proxy)
+	if err != nil {
+		return nil, err
+	}
+
+	return proxy.Plans, nil
+}
+
+// TrainingPlan will retrieve a training plan including the scheduled
+// workouts.
+func (c *Client) TrainingPlan(id int64) (*TrainingPlan, error) {
+	URL := fmt.Sprintf("https://connect.garmin.com/modern/proxy/trainingplan-service/trainingplan/tasks/%d", id)
+
+	if !c.authenticated() {
+		return nil, ErrNotAuthenticated
+	}
+
+	plan := new(TrainingPlan)
+
+	err := c.getJSON(URL, plan)
+	if err != nil {
+		return nil, err
+	}
+
+	return plan, nil
+}
+
+// workoutSportActivityTypes maps workout sports to activity types where the
+// keys differ.
+var workoutSportActivityTypes = map[string]string{
+	SportCardioTraining.Key: "indoor_cardio",
+}
+
+// sportMatches returns true if an activity of type typeID can complete a
+// workout of sport. Activity types match by their own key or the key of a
+// parent type, so a trail run completes a running workout. Workouts of
+// unknown or "other" sport can be completed by any activity.
+func sportMatches(sport WorkoutSportType, typeID int, types map[int]ActivityType) bool {
+	if sport.Key == "" || sport.Key == SportOther.Key {
+		return true
+	}
+
+	key := sport.Key
+	if k, found := workoutSportActivityTypes[key]; found {
+		key = k
+	}
+
+	// The depth is limited to guard against cycles.
+	for depth := 0; depth < 10; depth++ {
+		t, found := types[typeID]
+		if !found {
+			return false
+		}
+
+		if t.TypeKey == key || t.TypeKey == sport.Key {
+			return true
+		}
+
+		if t.ParentTypeID == 0 || t.ParentTypeID == t.TypeID {
+			return false
+		}
+
+		typeID = t.ParentTypeID
+	}
+
+	return false
+}
+
+// PlanAdherence will correlate tasks with activities from the calendar. A
+// task is considered completed if an activity of the same sport was done
+// the same day. types is used to resolve the activity type of calendar
+// items and can be retrieved by ActivityTypes(). Rest days are ignored. now
+// is used to tell missed workouts from upcoming.
+func PlanAdherence(tasks []TrainingPlanTask, calendar []CalendarItem, types []ActivityType, now time.Time) TrainingPlanAdherence {
+	activities := make(map[Date][]CalendarItem)
+	for _, item := range calendar {
+		if item.ItemType == CalendarItemActivity {
+			activities[item.Date] = append(activities[item.Date], item)
+		}
+	}
+
+	typesByID := make(map[int]ActivityType, len(types))
+	for _, t := range types {
+		typesByID[t.TypeID] = t
+	}
+
+	today := NewDate(now).Time()
+
+	var adherence TrainingPlanAdherence
+
+	for _, task := range tasks {
+		if task.Workout.RestDay {
+			continue
+		}
+
+		day := TrainingPlanDay{
+			Task: task,
+		}
+
+		// Each activity can only complete a single task.
+		done := activities[task.Date]
+		for i, item := range done {
+			if sportMatches(task.Workout.SportType, item.ActivityTypeID, typesByID) {
+				day.Activities = []CalendarItem{item}
+				day.Completed = true
+				activities[task.Date] = append(done[:i:i], done[i+1:]...)
+				break
+			}
+		}
+
+		date := task.Date.Time()
+		if !date.After(today) {
+			adherence.Scheduled++
+
+			if day.Completed {
+				adherence.Completed++
+			} else if date.Before(today) {
+				adherence.Missed++
+			}
+		}
+
+		adherence.Days = append(adherence.Days, day)
+	}
+
+	return adherence
+}
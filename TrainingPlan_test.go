@@ -0,0 +1,65 @@
+package connect
+
+import (
+	"testing"
+	"time"
+)
+
+func TestPlanAdherence(t *testing.T) {
+	task := func(day int, rest bool) TrainingPlanTask {
+		return TrainingPlanTask{
+			Date:    Date{2020, 3, day},
+			Workout: TrainingPlanWorkout{RestDay: rest, SportType: SportRunning},
+		}
+	}
+
+	types := []ActivityType{
+		{TypeID: 1, TypeKey: "running"},
+		{TypeID: 6, TypeKey: "trail_running", ParentTypeID: 1},
+		{TypeID: 9, TypeKey: "walking"},
+	}
+
+	tasks := []TrainingPlanTask{
+		task(2, false),
+		task(3, true),
+		task(4, false),
+		task(4, false),
+		task(5, false),
+		task(6, false),
+	}
+
+	calendar := []CalendarItem{
+		{ItemType: CalendarItemActivity, Date: Date{2020, 3, 2}, ActivityTypeID: 6},
+		{ItemType: CalendarItemActivity, Date: Date{2020, 3, 4}, ActivityTypeID: 9},
+		{ItemType: CalendarItemActivity, Date: Date{2020, 3, 4}, ActivityTypeID: 1},
+		{ItemType: CalendarItemWorkout, Date: Date{2020, 3, 5}},
+	}
+
+	adherence := PlanAdherence(tasks, calendar, types, time.Date(2020, 3, 5, 12, 0, 0, 0, time.UTC))
+
+	if len(adherence.Days) != 5 {
+		t.Fatalf("Expected 5 days without rest days, got %d", len(adherence.Days))
+	}
+
+	if adherence.Scheduled != 4 {
+		t.Errorf("Expected 4 scheduled workouts, got %d", adherence.Scheduled)
+	}
+
+	if adherence.Completed != 2 {
+		t.Errorf("Expected 2 completed workouts, got %d", adherence.Completed)
+	}
+
+	// The walk on the 4th doesn't complete a run.
+	if !adherence.Days[1].Completed || adherence.Days[1].Activities[0].ActivityTypeID != 1 {
+		t.Errorf("Expected the run to complete the first workout on the 4th")
+	}
+
+	if adherence.Days[2].Completed {
+		t.Errorf("Expected the walk not to complete a running workout")
+	}
+
+	// Today is not missed yet, and one of the two workouts on the 4th is.
+	if adherence.Missed != 1 {
+		t.Errorf("Expected 1 missed workout, got %d", adherence.Missed)
+	}
+}
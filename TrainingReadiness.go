@@ -0,0 +1,81 @@
+package connect
+
+import (
+	"fmt"
+	"time"
+)
+
+// TrainingReadiness is Garmins estimate of how ready the user is for
+// training. The factors are percentages.
+type TrainingReadiness struct {
+	Date                   Date          `json:"calendarDate"`
+	Timestamp              Time          `json:"timestamp"`
+	TimestampLocal         Time          `json:"timestampLocal"`
+	DeviceID               int64         `json:"deviceId"`
+	Score                  int           `json:"score"`
+	Level                  string        `json:"level"`
+	FeedbackShort          string        `json:"feedbackShort"`
+	FeedbackLong           string        `json:"feedbackLong"`
+	SleepScore             int           `json:"sleepScore"`
+	SleepScoreFactor       int           `json:"sleepScoreFactorPercent"`
+	RecoveryTime           time.Duration `json:"recoveryTime"`
+	RecoveryTimeFactor     int           `json:"recoveryTimeFactorPercent"`
+	AcuteLoad              int           `json:"acuteLoad"`
+	AcuteLoadFactor        int           `json:"acwrFactorPercent"`
+	StressHistoryFactor    int           `json:"stressHistoryFactorPercent"`
+	HRVWeeklyAverage       int           `json:"hrvWeeklyAverage"`
+	HRVFactor              int           `json:"hrvFactorPercent"`
+	SleepHistoryFactor     int           `json:"sleepHistoryFactorPercent"`
+	ValidSleep             bool          `json:"validSleep"`
+	InputContext           string        `json:"inputContext"`
+	PrimaryActivityTracker bool          `json:"primaryActivityTracker"`
+}
+
+// TrainingReadiness will retrieve the training readiness for date. Garmin
+// will update the readiness during the day, the latest is listed first.
+func (c *Client) TrainingReadiness(date time.Time) ([]TrainingReadiness, error) {
+	URL := fmt.Sprintf("https://connect.garmin.com/modern/proxy/metrics-service/metrics/trainingreadiness/%s",
+		formatDate(date))
+
+	if !c.authenticated() {
+		return nil, ErrNotAuthenticated
+	}
+
+	readiness := make([]TrainingReadiness, 0, 4)
+
+	err := c.getJSON(URL, &readiness)
+	if err != nil {
+		return nil, err
+	}
+
+	// Recovery time is in minutes.
+	for i := range readiness {
+		readiness[i].RecoveryTime *= time.Minute
+	}
+
+	return readiness, nil
+}
+
+// TrainingReadinessRange will retrieve the training readiness for all days
+// between from and to (both inclusive).
+func (c *Client) TrainingReadinessRange(from time.Time, to time.Time) ([]TrainingReadiness, error) {
+	days := make([][]TrainingReadiness, numDays(from, to))
+
+	err := forEachDay(from, to, func(i int, date time.Time) error {
+		var err error
+
+		days[i], err = c.TrainingReadiness(date)
+
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	readiness := make([]TrainingReadiness, 0, len(days))
+	for _, day := range days {
+		readiness = append(readiness, day...)
+	}
+
+	return readiness, nil
+}
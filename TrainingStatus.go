@@ -0,0 +1,121 @@
+package connect
+
+import (
+	"fmt"
+	"sort"
+	"time"
+)
+
+// TrainingStatusCode is the training status as determined by Garmin.
+type TrainingStatusCode int
+
+// String implements Stringer.
+func (s TrainingStatusCode) String() string {
+	switch s {
+	case 0:
+		return "no-status"
+	case 1:
+		return "detraining"
+	case 2:
+		return "recovery"
+	case 3:
+		return "maintaining"
+	case 4:
+		return "productive"
+	case 5:
+		return "peaking"
+	case 6:
+		return "overreaching"
+	case 7:
+		return "unproductive"
+	case 8:
+		return "strained"
+	default:
+		return fmt.Sprintf("unknown:%d", s)
+	}
+}
+
+// AcuteTrainingLoad is Garmins version of the acute:chronic workload ratio.
+type AcuteTrainingLoad struct {
+	Acute   float64 `json:"dailyTrainingLoadAcute"`
+	Chronic float64 `json:"dailyTrainingLoadChronic"`
+	Ratio   float64 `json:"dailyAcuteChronicWorkloadRatio"`
+	Status  string  `json:"acwrStatus"`
+}
+
+// TrainingStatus is the training status for a single day as reported by a
+// single device.
+type TrainingStatus struct {
+	Date          Date               `json:"calendarDate"`
+	DeviceID      int64              `json:"deviceId"`
+	Status        TrainingStatusCode `json:"trainingStatus"`
+	Feedback      string             `json:"trainingStatusFeedbackPhrase"`
+	WeeklyLoad    float64            `json:"weeklyTrainingLoad"`
+	LoadTunnelMin float64            `json:"loadTunnelMin"`
+	LoadTunnelMax float64            `json:"loadTunnelMax"`
+	FitnessTrend  int                `json:"fitnessTrend"`
+	AcuteLoad     AcuteTrainingLoad  `json:"acuteTrainingLoadDTO"`
+	PrimaryDevice bool               `json:"primaryTrainingDevice"`
+}
+
+// TrainingStatus will retrieve the training status for date. One status will
+// be returned for each device reporting a training status.
+func (c *Client) TrainingStatus(date time.Time) ([]TrainingStatus, error) {
+	URL := fmt.Sprintf("https://connect.garmin.com/modern/proxy/metrics-service/metrics/trainingstatus/aggregated/%s",
+		formatDate(date))
+
+	if !c.authenticated() {
+		return nil, ErrNotAuthenticated
+	}
+
+	var proxy struct {
+		MostRecent struct {
+			Latest map[string]TrainingStatus `json:"latestTrainingStatusData"`
+		} `json:"mostRecentTrainingStatus"`
+	}
+
+	err := c.getJSON(URL, &proxy)
+	if err != nil {
+		return nil, err
+	}
+
+	statuses := make([]TrainingStatus, 0, len(proxy.MostRecent.Latest))
+	for _, status := range proxy.MostRecent.Latest {
+		statuses = append(statuses, status)
+	}
+
+	// Make sure the primary device is listed first.
+	sort.Slice(statuses, func(i, j int) bool {
+		if statuses[i].PrimaryDevice != statuses[j].PrimaryDevice {
+			return statuses[i].PrimaryDevice
+		}
+
+		return statuses[i].DeviceID < statuses[j].DeviceID
+	})
+
+	return statuses, nil
+}
+
+// TrainingStatusRange will retrieve the training status for all days
+// between from and to (both inclusive).
+func (c *Client) TrainingStatusRange(from time.Time, to time.Time) ([]TrainingStatus, error) {
+	days := make([][]TrainingStatus, numDays(from, to))
+
+	err := forEachDay(from, to, func(i int, date time.Time) error {
+		var err error
+
+		days[i], err = c.TrainingStatus(date)
+
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	statuses := make([]TrainingStatus, 0, len(days))
+	for _, day := range days {
+		statuses = append(statuses, day...)
+	}
+
+	return statuses, nil
+}
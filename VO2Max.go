@@ -0,0 +1,60 @@
+package connect
+
+import (
+	"fmt"
+	"time"
+)
+
+// VO2Max is an estimated maximum oxygen uptake for a single sport on a
+// given date. Value is in mL/kg/min.
+type VO2Max struct {
+	Date         Date    `json:"calendarDate"`
+	Sport        string  `json:"-"`
+	Value        float64 `json:"vo2MaxValue"`
+	PreciseValue float64 `json:"vo2MaxPreciseValue"`
+	Category     int     `json:"maxMetCategory"`
+}
+
+// VO2Max will retrieve the VO2max estimates for date.
+func (c *Client) VO2Max(date time.Time) ([]VO2Max, error) {
+	return c.VO2MaxRange(date, date)
+}
+
+// VO2MaxRange will retrieve all VO2max estimates for running and cycling
+// between from and to (both inclusive). Sport will be set to either
+// "running" or "cycling".
+func (c *Client) VO2MaxRange(from time.Time, to time.Time) ([]VO2Max, error) {
+	URL := fmt.Sprintf("https://connect.garmin.com/modern/proxy/metrics-service/metrics/maxmet/daily/%s/%s",
+		formatDate(from),
+		formatDate(to),
+	)
+
+	if !c.authenticated() {
+		return nil, ErrNotAuthenticated
+	}
+
+	var proxy []struct {
+		Running *VO2Max `json:"generic"`
+		Cycling *VO2Max `json:"cycling"`
+	}
+
+	err := c.getJSON(URL, &proxy)
+	if err != nil {
+		return nil, err
+	}
+
+	estimates := make([]VO2Max, 0, len(proxy))
+	for _, p := range proxy {
+		if p.Running != nil {
+			p.Running.Sport = "running"
+			estimates = append(estimates, *p.Running)
+		}
+
+		if p.Cycling != nil {
+			p.Cycling.Sport = "cycling"
+			estimates = append(estimates, *p.Cycling)
+		}
+	}
+
+	return estimates, nil
+}
@@ -1,7 +1,10 @@
 package connect
 
 import (
+	"bytes"
 	"fmt"
+	"io"
+	"math"
 	"time"
 )
 
@@ -9,15 +12,32 @@ import (
 type Weightin struct {
 	Date              Date    `json:"date"`
 	Version           int     `json:"version"`
+	SamplePK          int64   `json:"samplePk"`
+	Timestamp         Time    `json:"timestampGMT"`
 	Weight            float64 `json:"weight"`     // gram
 	BMI               float64 `json:"bmi"`        // weight / height²
 	BodyFatPercentage float64 `json:"bodyFat"`    // percent
-	BodyWater         float64 `json:"bodyWater"`  // kilogram
+	BodyWater         float64 `json:"bodyWater"`  // percent
 	BoneMass          int     `json:"boneMass"`   // gram
 	MuscleMass        int     `json:"muscleMass"` // gram
 	SourceType        string  `json:"sourceType"`
 }
 
+// BodyComposition is a complete weigh-in as measured by a body composition
+// scale. Zero values are considered unknown and will not be stored.
+type BodyComposition struct {
+	Timestamp           time.Time
+	Weight              float64 // gram
+	BMI                 float64 // weight / height²
+	BodyFatPercentage   float64 // percent
+	BodyWaterPercentage float64 // percent
+	BoneMass            float64 // gram
+	MuscleMass          float64 // gram
+	VisceralFatRating   int
+	MetabolicAge        int // years
+	PhysiqueRating      int
+}
+
 // WeightAverage is aggregated weight data for a specific period.
 type WeightAverage struct {
 	Weightin
@@ -80,6 +100,116 @@ func (c *Client) DeleteWeightin(date time.Time) error {
 	return c.write("DELETE", URL, nil, 204)
 }
 
+// WeightinsByDate will retrieve all individual weigh-ins for date. The
+// SamplePK of each weigh-in can be used to delete or update it.
+func (c *Client) WeightinsByDate(date time.Time) ([]Weightin, error) {
+	URL := fmt.Sprintf("https://connect.garmin.com/modern/proxy/weight-service/weight/dayview/%s",
+		formatDate(date))
+
+	if !c.authenticated() {
+		return nil, ErrNotAuthenticated
+	}
+
+	var proxy struct {
+		DateWeightList []Weightin `json:"dateWeightList"`
+	}
+
+	err := c.getJSON(URL, &proxy)
+	if err != nil {
+		return nil, err
+	}
+
+	return proxy.DateWeightList, nil
+}
+
+// DeleteWeightinSample will delete a single weigh-in from date. Other
+// weigh-ins and biometric data for date will be kept.
+func (c *Client) DeleteWeightinSample(date time.Time, samplePK int64) error {
+	URL := fmt.Sprintf("https://connect.garmin.com/modern/proxy/weight-service/weight/%s/byversion/%d",
+		formatDate(date),
+		samplePK)
+
+	if !c.authenticated() {
+		return ErrNotAuthenticated
+	}
+
+	return c.write("DELETE", URL, nil, 204)
+}
+
+// UpdateWeightin will change the time and weight of an existing weigh-in.
+// Garmin Connect has no way to edit a weigh-in, so a new weigh-in will be
+// added before the old is deleted. Body composition is carried over to the
+// new weigh-in.
+func (c *Client) UpdateWeightin(weightin Weightin, timestamp time.Time, weight float64) error {
+	if weightin.SamplePK == 0 {
+		return Error("weigh-in has no sample PK")
+	}
+
+	var err error
+
+	composition, found := weightin.bodyComposition(timestamp, weight)
+	if found {
+		err = c.AddWeightin(composition)
+	} else {
+		err = c.addUserWeightAt(timestamp, weight)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	return c.DeleteWeightinSample(weightin.Date.Time(), weightin.SamplePK)
+}
+
+// bodyComposition returns the body composition of w for a new weight at
+// timestamp. Percentages and masses are kept and BMI is scaled to the new
+// weight. The boolean will be false if w has no body composition.
+func (w *Weightin) bodyComposition(timestamp time.Time, weight float64) (BodyComposition, bool) {
+	if w.BodyFatPercentage == 0.0 && w.BodyWater == 0.0 && w.BoneMass == 0 && w.MuscleMass == 0 {
+		return BodyComposition{}, false
+	}
+
+	b := BodyComposition{
+		Timestamp:           timestamp,
+		Weight:              weight,
+		BodyFatPercentage:   w.BodyFatPercentage,
+		BodyWaterPercentage: w.BodyWater,
+		BoneMass:            float64(w.BoneMass),
+		MuscleMass:          float64(w.MuscleMass),
+	}
+
+	if w.Weight > 0.0 {
+		b.BMI = w.BMI * weight / w.Weight
+	}
+
+	return b, true
+}
+
+// addUserWeightAt will add a manual weigh-in at a specific time of day.
+func (c *Client) addUserWeightAt(timestamp time.Time, weight float64) error {
+	URL := "https://connect.garmin.com/modern/proxy/weight-service/user-weight"
+
+	if !c.authenticated() {
+		return ErrNotAuthenticated
+	}
+
+	const layout = "2006-01-02T15:04:05.00"
+
+	payload := struct {
+		DateTimestamp string  `json:"dateTimestamp"`
+		GMTTimestamp  string  `json:"gmtTimestamp"`
+		UnitKey       string  `json:"unitKey"`
+		Value         float64 `json:"value"`
+	}{
+		DateTimestamp: timestamp.Format(layout),
+		GMTTimestamp:  timestamp.UTC().Format(layout),
+		UnitKey:       "kg",
+		Value:         weight / 1000.0,
+	}
+
+	return c.write("POST", URL, payload, 204)
+}
+
 // AddUserWeight will add a manual weight in. weight is in grams to match
 // Weightin.
 func (c *Client) AddUserWeight(date time.Time, weight float64) error {
@@ -97,6 +227,83 @@ func (c *Client) AddUserWeight(date time.Time, weight float64) error {
 	return c.write("POST", URL, payload, 204)
 }
 
+// AddWeightin will add a complete weigh-in including body composition. This
+// is done by uploading a FIT weight scale file like a Garmin Index scale
+// would.
+func (c *Client) AddWeightin(weightin BodyComposition) error {
+	if !c.authenticated() {
+		return ErrNotAuthenticated
+	}
+
+	if weightin.Weight <= 0.0 {
+		return Error("weight is required")
+	}
+
+	buffer := bytes.NewBuffer(nil)
+
+	err := weightin.writeFIT(buffer)
+	if err != nil {
+		return err
+	}
+
+	// Weigh-ins are processed asynchronously.
+	_, err = c.upload(buffer, "weight.fit", true)
+
+	return err
+}
+
+// writeFIT will write b as a FIT weight scale file to w.
+func (b *BodyComposition) writeFIT(w io.Writer) error {
+	timestamp := b.Timestamp
+	if timestamp.IsZero() {
+		timestamp = time.Now()
+	}
+
+	e := newFitEncoder()
+
+	// file_id. Type 9 is weight and manufacturer 255 is development.
+	e.message(0,
+		fitValue(0, fitEnum, 9),
+		fitValue(1, fitUint16, 255),
+		fitValue(2, fitUint16, 0),
+		fitValue(3, fitUint32z, 1),
+		fitValue(4, fitUint32, fitTime(time.Now())),
+	)
+
+	// Scaled values as required by the FIT profile.
+	fields := []fitField{
+		fitValue(253, fitUint32, fitTime(timestamp)),
+		fitValue(0, fitUint16, int64(math.Round(b.Weight/10.0))),
+	}
+
+	optional := []struct {
+		num   byte
+		typ   fitBaseType
+		value float64
+		scale float64
+	}{
+		{1, fitUint16, b.BodyFatPercentage, 100.0},
+		{2, fitUint16, b.BodyWaterPercentage, 100.0},
+		{4, fitUint16, b.BoneMass / 1000.0, 100.0},
+		{5, fitUint16, b.MuscleMass / 1000.0, 100.0},
+		{8, fitUint8, float64(b.PhysiqueRating), 1.0},
+		{10, fitUint8, float64(b.MetabolicAge), 1.0},
+		{11, fitUint8, float64(b.VisceralFatRating), 1.0},
+		{13, fitUint16, b.BMI, 10.0},
+	}
+
+	for _, o := range optional {
+		if o.value > 0.0 {
+			fields = append(fields, fitValue(o.num, o.typ, int64(math.Round(o.value*o.scale))))
+		}
+	}
+
+	// weight_scale.
+	e.message(30, fields...)
+
+	return e.writeTo(w)
+}
+
 // WeightByDate retrieves the weight of date if available. If no weight data
 // for date exists, it will return ErrNotFound.
 func (c *Client) WeightByDate(date time.Time) (Time, float64, error) {
@@ -127,7 +334,7 @@ func (c *Client) WeightByDate(date time.Time) (Time, float64, error) {
 // WeightGoal will list the users weight goal if any. If displayName is empty,
 // the currently authenticated user will be used.
 func (c *Client) WeightGoal(displayName string) (*Goal, error) {
-	goals, err := c.Goals(displayName, 4)
+	goals, err := c.Goals(displayName, int(GoalTypeWeight))
 	if err != nil {
 		return nil, err
 	}
@@ -148,12 +355,12 @@ func (c *Client) SetWeightGoal(goal int) error {
 	g := Goal{
 		Created:   Today(),
 		Start:     Today(),
-		GoalType:  4,
+		GoalType:  GoalTypeWeight,
 		ProfileID: c.Profile.ProfileID,
 		Value:     goal,
 	}
 
-	goals, err := c.Goals("", 4)
+	goals, err := c.Goals("", int(GoalTypeWeight))
 	if err != nil {
 		return err
 	}
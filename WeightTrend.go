@@ -0,0 +1,124 @@
+package connect
+
+import (
+	"math"
+	"sort"
+	"time"
+)
+
+// WeightTrendPoint is the average weight of a single day along with the
+// smoothed trend.
+type WeightTrendPoint struct {
+	Date   Date
+	Weight float64 // gram
+	Trend  float64 // gram
+}
+
+// WeightTrend is an analysis of weigh-ins over a period.
+type WeightTrend struct {
+	Points []WeightTrendPoint
+
+	// WeeklyRate is the change in trend per week in grams. It's negative
+	// when losing weight.
+	WeeklyRate float64
+
+	// Variance is the variance of the weigh-ins around the trend in gram².
+	Variance float64
+}
+
+// AnalyzeWeight will calculate an exponentially smoothed trend for
+// weightins. alpha is the smoothing factor per day, 0.1 is a sensible
+// default. Weigh-ins on the same day are averaged.
+func AnalyzeWeight(weightins []Weightin, alpha float64) *WeightTrend {
+	sums := make(map[Date]float64)
+	counts := make(map[Date]int)
+
+	for _, w := range weightins {
+		if w.Weight < 1.0 {
+			continue
+		}
+
+		sums[w.Date] += w.Weight
+		counts[w.Date]++
+	}
+
+	trend := &WeightTrend{
+		Points: make([]WeightTrendPoint, 0, len(sums)),
+	}
+
+	for date, sum := range sums {
+		trend.Points = append(trend.Points, WeightTrendPoint{
+			Date:   date,
+			Weight: sum / float64(counts[date]),
+		})
+	}
+
+	sort.Slice(trend.Points, func(i, j int) bool {
+		return trend.Points[i].Date.Time().Before(trend.Points[j].Date.Time())
+	})
+
+	if len(trend.Points) == 0 {
+		return trend
+	}
+
+	// Smoothing is done per day, so gaps between weigh-ins will move the
+	// trend further towards the new weight.
+	trend.Points[0].Trend = trend.Points[0].Weight
+	for i := 1; i < len(trend.Points); i++ {
+		days := trend.Points[i].Date.Time().Sub(trend.Points[i-1].Date.Time()).Hours() / 24
+		a := 1.0 - math.Pow(1.0-alpha, days)
+
+		previous := trend.Points[i-1].Trend
+		trend.Points[i].Trend = previous + a*(trend.Points[i].Weight-previous)
+	}
+
+	// Least squares fit of the trend to get the rate of change.
+	first := trend.Points[0].Date.Time()
+	var sumX, sumY, sumXY, sumXX float64
+	for _, p := range trend.Points {
+		x := p.Date.Time().Sub(first).Hours() / 24
+		sumX += x
+		sumY += p.Trend
+		sumXY += x * p.Trend
+		sumXX += x * x
+	}
+
+	n := float64(len(trend.Points))
+	if denominator := n*sumXX - sumX*sumX; denominator > 0.0 {
+		trend.WeeklyRate = 7.0 * (n*sumXY - sumX*sumY) / denominator
+	}
+
+	for _, p := range trend.Points {
+		trend.Variance += (p.Weight - p.Trend) * (p.Weight - p.Trend)
+	}
+	trend.Variance /= n
+
+	return trend
+}
+
+// Current returns the latest trend point. The zero value will be returned
+// if there is no weigh-ins.
+func (t *WeightTrend) Current() WeightTrendPoint {
+	if len(t.Points) == 0 {
+		return WeightTrendPoint{}
+	}
+
+	return t.Points[len(t.Points)-1]
+}
+
+// ProjectGoal will project when goal (in grams) will be reached if the
+// current rate is kept. The second return value will be false if the trend
+// is moving away from goal.
+func (t *WeightTrend) ProjectGoal(goal float64) (time.Time, bool) {
+	current := t.Current()
+	if len(t.Points) == 0 || t.WeeklyRate == 0.0 {
+		return time.Time{}, false
+	}
+
+	weeks := (goal - current.Trend) / t.WeeklyRate
+	if weeks < 0.0 {
+		return time.Time{}, false
+	}
+
+	return current.Date.Time().Add(time.Duration(weeks * 7 * 24 * float64(time.Hour))), true
+}
@@ -0,0 +1,54 @@
+package connect
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestAnalyzeWeight(t *testing.T) {
+	var weightins []Weightin
+
+	// Losing 100 grams per day.
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	for day := 0; day < 28; day++ {
+		weightins = append(weightins, Weightin{
+			Date:   NewDate(start.AddDate(0, 0, day)),
+			Weight: 80000.0 - 100.0*float64(day),
+		})
+	}
+
+	trend := AnalyzeWeight(weightins, 0.1)
+
+	if len(trend.Points) != 28 {
+		t.Fatalf("Expected 28 points, got %d", len(trend.Points))
+	}
+
+	// The smoothed trend is lagging, but the rate should settle close to
+	// the real rate.
+	if trend.WeeklyRate > -500.0 || trend.WeeklyRate < -700.0 {
+		t.Errorf("Expected weekly rate close to -700 g, got %f", trend.WeeklyRate)
+	}
+
+	if trend.Current().Trend <= trend.Current().Weight {
+		t.Errorf("Expected trend to lag behind a falling weight")
+	}
+
+	when, ok := trend.ProjectGoal(70000.0)
+	if !ok {
+		t.Fatalf("Expected goal to be reachable")
+	}
+
+	if !when.After(start.AddDate(0, 0, 28)) {
+		t.Errorf("Expected goal to be reached in the future, got %s", when)
+	}
+
+	_, ok = trend.ProjectGoal(90000.0)
+	if ok {
+		t.Errorf("Expected goal above current weight to be unreachable")
+	}
+
+	if math.IsNaN(trend.Variance) || trend.Variance <= 0.0 {
+		t.Errorf("Expected positive variance, got %f", trend.Variance)
+	}
+}
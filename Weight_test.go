@@ -0,0 +1,65 @@
+package connect
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestWeightinEditRoundTrip(t *testing.T) {
+	in := `{"samplePk":17,"date":1577865600000,"weight":80500.0,"bmi":24.8,` +
+		`"bodyFat":18.5,"bodyWater":55.4,"boneMass":3200,"muscleMass":36000}`
+
+	var weightin Weightin
+	err := json.Unmarshal([]byte(in), &weightin)
+	if err != nil {
+		t.Fatalf("Unmarshal() returned %s", err.Error())
+	}
+
+	timestamp := time.Date(2020, 1, 1, 7, 30, 0, 0, time.UTC)
+
+	b, found := weightin.bodyComposition(timestamp, 79500.0)
+	if !found {
+		t.Fatalf("Expected body composition to be found")
+	}
+
+	if b.BodyWaterPercentage != 55.4 || b.BodyFatPercentage != 18.5 {
+		t.Errorf("Expected percentages to be kept, got water %f and fat %f", b.BodyWaterPercentage, b.BodyFatPercentage)
+	}
+
+	buffer := bytes.NewBuffer(nil)
+
+	err = b.writeFIT(buffer)
+	if err != nil {
+		t.Fatalf("Failed to write FIT file: %s", err.Error())
+	}
+
+	messages := decodeFIT(t, buffer.Bytes())
+
+	scale := messages[len(messages)-1]
+	if scale.global != 30 {
+		t.Fatalf("Expected weight_scale message last, got %d", scale.global)
+	}
+
+	if scale.uint(0) != 7950 {
+		t.Errorf("Expected weight of 79.5 kg, got %d", scale.uint(0))
+	}
+
+	if scale.uint(2) != 5540 {
+		t.Errorf("Expected body water of 55.4%%, got %d", scale.uint(2))
+	}
+
+	if scale.uint(4) != 320 || scale.uint(5) != 3600 {
+		t.Errorf("Expected bone and muscle mass to be kept, got %d and %d", scale.uint(4), scale.uint(5))
+	}
+}
+
+func TestWeightinWithoutComposition(t *testing.T) {
+	w := Weightin{Weight: 80500.0, BMI: 24.8}
+
+	_, found := w.bodyComposition(time.Now(), 79500.0)
+	if found {
+		t.Errorf("Expected no body composition")
+	}
+}
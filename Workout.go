@@ -0,0 +1,246 @@
+package connect
+
+import (
+	"fmt"
+	"time"
+)
+
+// Step types for use in WorkoutStep.
+const (
+	stepTypeExecutable = "ExecutableStepDTO"
+	stepTypeRepeat     = "RepeatGroupDTO"
+)
+
+// WorkoutSportType is the sport of a workout or a workout segment.
+type WorkoutSportType struct {
+	ID  int    `json:"sportTypeId"`
+	Key string `json:"sportTypeKey"`
+}
+
+// Known sport types.
+var (
+	SportRunning          = WorkoutSportType{1, "running"}
+	SportCycling          = WorkoutSportType{2, "cycling"}
+	SportOther            = WorkoutSportType{3, "other"}
+	SportSwimming         = WorkoutSportType{4, "swimming"}
+	SportStrengthTraining = WorkoutSportType{5, "strength_training"}
+	SportCardioTraining   = WorkoutSportType{6, "cardio_training"}
+	SportYoga             = WorkoutSportType{7, "yoga"}
+	SportPilates          = WorkoutSportType{8, "pilates"}
+	SportHIIT             = WorkoutSportType{9, "hiit"}
+)
+
+// WorkoutStepType is the intensity of a workout step.
+type WorkoutStepType struct {
+	ID  int    `json:"stepTypeId"`
+	Key string `json:"stepTypeKey"`
+}
+
+// Known step types.
+var (
+	StepWarmup   = WorkoutStepType{1, "warmup"}
+	StepCooldown = WorkoutStepType{2, "cooldown"}
+	StepInterval = WorkoutStepType{3, "interval"}
+	StepRecovery = WorkoutStepType{4, "recovery"}
+	StepRest     = WorkoutStepType{5, "rest"}
+	StepRepeat   = WorkoutStepType{6, "repeat"}
+	StepOther    = WorkoutStepType{7, "other"}
+)
+
+// WorkoutEndCondition describes when a workout step ends.
+type WorkoutEndCondition struct {
+	ID  int    `json:"conditionTypeId"`
+	Key string `json:"conditionTypeKey"`
+}
+
+// Known end conditions.
+var (
+	EndLapButton  = WorkoutEndCondition{1, "lap.button"}
+	EndTime       = WorkoutEndCondition{2, "time"}
+	EndDistance   = WorkoutEndCondition{3, "distance"}
+	EndCalories   = WorkoutEndCondition{4, "calories"}
+	EndHeartRate  = WorkoutEndCondition{5, "heart.rate"}
+	EndPower      = WorkoutEndCondition{6, "power"}
+	EndIterations = WorkoutEndCondition{7, "iterations"}
+)
+
+// WorkoutTargetType is the type of target for a workout step.
+type WorkoutTargetType struct {
+	ID  int    `json:"workoutTargetTypeId"`
+	Key string `json:"workoutTargetTypeKey"`
+}
+
+// Known target types.
+var (
+	TargetNone      = WorkoutTargetType{1, "no.target"}
+	TargetPower     = WorkoutTargetType{2, "power.zone"}
+	TargetCadence   = WorkoutTargetType{3, "cadence"}
+	TargetHeartRate = WorkoutTargetType{4, "heart.rate.zone"}
+	TargetSpeed     = WorkoutTargetType{5, "speed.zone"}
+	TargetPace      = WorkoutTargetType{6, "pace.zone"}
+)
+
+// WorkoutStep is a single step in a workout. A step is either executable
+// or a repeat group containing other steps.
+type WorkoutStep struct {
+	Type        string          `json:"type"`
+	ID          int64           `json:"stepId,omitempty"`
+	Order       int             `json:"stepOrder"`
+	StepType    WorkoutStepType `json:"stepType"`
+	Description string          `json:"description,omitempty"`
+
+	// EndConditionValue is seconds for EndTime, meters for EndDistance and
+	// the number of iterations for EndIterations.
+	EndCondition      WorkoutEndCondition `json:"endCondition"`
+	EndConditionValue float64             `json:"endConditionValue,omitempty"`
+
+	// For heart rate and power the target can be given as a zone, or as a
+	// range between TargetValueOne and TargetValueTwo. Pace and speed
+	// targets are in m/s.
+	TargetType     *WorkoutTargetType `json:"targetType,omitempty"`
+	TargetValueOne float64            `json:"targetValueOne,omitempty"`
+	TargetValueTwo float64            `json:"targetValueTwo,omitempty"`
+	ZoneNumber     int                `json:"zoneNumber,omitempty"`
+
+	// Only used by repeat groups.
+	Iterations  int           `json:"numberOfIterations,omitempty"`
+	SmartRepeat bool          `json:"smartRepeat,omitempty"`
+	Steps       []WorkoutStep `json:"workoutSteps,omitempty"`
+}
+
+// IsRepeat returns true if the step is a repeat group.
+func (s *WorkoutStep) IsRepeat() bool {
+	return s.Type == stepTypeRepeat
+}
+
+// Duration returns the duration of the step if it ends after a fixed time.
+// Repeat groups will return the total duration of all iterations if all
+// steps are timed.
+func (s *WorkoutStep) Duration() time.Duration {
+	if s.IsRepeat() {
+		var d time.Duration
+		for i := range s.Steps {
+			stepDuration := s.Steps[i].Duration()
+			if stepDuration == 0 {
+				return 0
+			}
+
+			d += stepDuration
+		}
+
+		return time.Duration(s.Iterations) * d
+	}
+
+	if s.EndCondition != EndTime {
+		return 0
+	}
+
+	return time.Duration(s.EndConditionValue * float64(time.Second))
+}
+
+// WorkoutSegment is a segment of a workout. Most workouts consist of a
+// single segment, multisport workouts can have more.
+type WorkoutSegment struct {
+	Order     int              `json:"segmentOrder"`
+	SportType WorkoutSportType `json:"sportType"`
+	Steps     []WorkoutStep    `json:"workoutSteps"`
+}
+
+// Workout is a structured workout in the workout library.
+type Workout struct {
+	ID                int64            `json:"workoutId,omitempty"`
+	OwnerID           int64            `json:"ownerId,omitempty"`
+	Name              string           `json:"workoutName"`
+	Description       string           `json:"description,omitempty"`
+	SportType         WorkoutSportType `json:"sportType"`
+	Segments          []WorkoutSegment `json:"workoutSegments"`
+	EstimatedDuration int              `json:"estimatedDurationInSecs,omitempty"`
+	EstimatedDistance float64          `json:"estimatedDistanceInMeters,omitempty"`
+	Created           *Time            `json:"createdDate,omitempty"`
+	Updated           *Time            `json:"updatedDate,omitempty"`
+}
+
+// Workouts will list the workouts in the workout library of the
+// authenticated user. Workout segments are not included, use Workout() to
+// retrieve a complete workout.
+func (c *Client) Workouts(start int, limit int) ([]Workout, error) {
+	URL := fmt.Sprintf("https://connect.garmin.com/modern/proxy/workout-service/workouts?start=%d&limit=%d&myWorkoutsOnly=true",
+		start,
+		limit)
+
+	if !c.authenticated() {
+		return nil, ErrNotAuthenticated
+	}
+
+	var workouts []Workout
+
+	err := c.getJSON(URL, &workouts)
+	if err != nil {
+		return nil, err
+	}
+
+	return workouts, nil
+}
+
+// Workout will retrieve a complete workout.
+func (c *Client) Workout(id int64) (*Workout, error) {
+	URL := fmt.Sprintf("https://connect.garmin.com/modern/proxy/workout-service/workout/%d", id)
+
+	if !c.authenticated() {
+		return nil, ErrNotAuthenticated
+	}
+
+	workout := new(Workout)
+
+	err := c.getJSON(URL, workout)
+	if err != nil {
+		return nil, err
+	}
+
+	return workout, nil
+}
+
+// CreateWorkout will add workout to the workout library. The workout as
+// stored by Garmin Connect will be returned.
+func (c *Client) CreateWorkout(workout *Workout) (*Workout, error) {
+	URL := "https://connect.garmin.com/modern/proxy/workout-service/workout"
+
+	if !c.authenticated() {
+		return nil, ErrNotAuthenticated
+	}
+
+	created := new(Workout)
+
+	err := c.writeJSON("POST", URL, workout, created)
+	if err != nil {
+		return nil, err
+	}
+
+	return created, nil
+}
+
+// UpdateWorkout will replace an existing workout. workout.ID must be set.
+func (c *Client) UpdateWorkout(workout *Workout) error {
+	if workout.ID == 0 {
+		return Error("workout has no ID")
+	}
+
+	URL := fmt.Sprintf("https://connect.garmin.com/modern/proxy/workout-service/workout/%d", workout.ID)
+
+	if !c.authenticated() {
+		return ErrNotAuthenticated
+	}
+
+	return c.write("PUT", URL, workout, 204)
+}
+
+// DeleteWorkout will delete a workout from the workout library.
+func (c *Client) DeleteWorkout(id int64) error {
+	URL := fmt.Sprintf("https://connect.garmin.com/modern/proxy/workout-service/workout/%d", id)
+
+	if !c.authenticated() {
+		return ErrNotAuthenticated
+	}
+
+	return c.write("DELETE", URL, nil, 204)
+}
@@ -0,0 +1,213 @@
+package connect
+
+import (
+	"time"
+)
+
+// WorkoutEnd is the end condition of a workout step.
+type WorkoutEnd struct {
+	Condition WorkoutEndCondition
+	Value     float64
+}
+
+// WorkoutTarget is the target of a workout step.
+type WorkoutTarget struct {
+	Type     WorkoutTargetType
+	ValueOne float64
+	ValueTwo float64
+	Zone     int
+}
+
+// ForDuration ends a step after d.
+func ForDuration(d time.Duration) WorkoutEnd {
+	return WorkoutEnd{EndTime, d.Seconds()}
+}
+
+// ForDistance ends a step after meters.
+func ForDistance(meters float64) WorkoutEnd {
+	return WorkoutEnd{EndDistance, meters}
+}
+
+// ForCalories ends a step after burning calories.
+func ForCalories(calories float64) WorkoutEnd {
+	return WorkoutEnd{EndCalories, calories}
+}
+
+// UntilLapButton ends a step when the lap button is pressed.
+func UntilLapButton() WorkoutEnd {
+	return WorkoutEnd{EndLapButton, 0}
+}
+
+// NoTarget is a step without any target.
+func NoTarget() WorkoutTarget {
+	return WorkoutTarget{Type: TargetNone}
+}
+
+// HeartRateZone targets a heart rate zone.
+func HeartRateZone(zone int) WorkoutTarget {
+	return WorkoutTarget{Type: TargetHeartRate, Zone: zone}
+}
+
+// HeartRateRange targets a heart rate between low and high bpm.
+func HeartRateRange(low int, high int) WorkoutTarget {
+	return WorkoutTarget{Type: TargetHeartRate, ValueOne: float64(low), ValueTwo: float64(high)}
+}
+
+// PowerZone targets a power zone.
+func PowerZone(zone int) WorkoutTarget {
+	return WorkoutTarget{Type: TargetPower, Zone: zone}
+}
+
+// PowerRange targets a power between low and high watts.
+func PowerRange(low int, high int) WorkoutTarget {
+	return WorkoutTarget{Type: TargetPower, ValueOne: float64(low), ValueTwo: float64(high)}
+}
+
+// CadenceRange targets a cadence between low and high.
+func CadenceRange(low int, high int) WorkoutTarget {
+	return WorkoutTarget{Type: TargetCadence, ValueOne: float64(low), ValueTwo: float64(high)}
+}
+
+// PaceRange targets a pace between slow and fast per kilometer.
+func PaceRange(slow time.Duration, fast time.Duration) WorkoutTarget {
+	return WorkoutTarget{
+		Type:     TargetPace,
+		ValueOne: 1000.0 / slow.Seconds(),
+		ValueTwo: 1000.0 / fast.Seconds(),
+	}
+}
+
+// SpeedRange targets a speed between low and high m/s.
+func SpeedRange(low float64, high float64) WorkoutTarget {
+	return WorkoutTarget{Type: TargetSpeed, ValueOne: low, ValueTwo: high}
+}
+
+// WorkoutBuilder can be used to build a workout step by step.
+type WorkoutBuilder struct {
+	workout Workout
+	steps   []WorkoutStep
+}
+
+// NewWorkoutBuilder will start building a workout.
+func NewWorkoutBuilder(name string, sport WorkoutSportType) *WorkoutBuilder {
+	return &WorkoutBuilder{
+		workout: Workout{
+			Name:      name,
+			SportType: sport,
+		},
+	}
+}
+
+// Description sets the description of the workout.
+func (b *WorkoutBuilder) Description(description string) *WorkoutBuilder {
+	b.workout.Description = description
+
+	return b
+}
+
+// Step adds a step of any type.
+func (b *WorkoutBuilder) Step(stepType WorkoutStepType, end WorkoutEnd, target WorkoutTarget) *WorkoutBuilder {
+	targetType := target.Type
+	if targetType == (WorkoutTargetType{}) {
+		targetType = TargetNone
+	}
+
+	b.steps = append(b.steps, WorkoutStep{
+		Type:              stepTypeExecutable,
+		StepType:          stepType,
+		EndCondition:      end.Condition,
+		EndConditionValue: end.Value,
+		TargetType:        &targetType,
+		TargetValueOne:    target.ValueOne,
+		TargetValueTwo:    target.ValueTwo,
+		ZoneNumber:        target.Zone,
+	})
+
+	return b
+}
+
+// Warmup adds a warmup step.
+func (b *WorkoutBuilder) Warmup(end WorkoutEnd, target WorkoutTarget) *WorkoutBuilder {
+	return b.Step(StepWarmup, end, target)
+}
+
+// Interval adds an interval step.
+func (b *WorkoutBuilder) Interval(end WorkoutEnd, target WorkoutTarget) *WorkoutBuilder {
+	return b.Step(StepInterval, end, target)
+}
+
+// Recovery adds a recovery step.
+func (b *WorkoutBuilder) Recovery(end WorkoutEnd, target WorkoutTarget) *WorkoutBuilder {
+	return b.Step(StepRecovery, end, target)
+}
+
+// Rest adds a rest step.
+func (b *WorkoutBuilder) Rest(end WorkoutEnd) *WorkoutBuilder {
+	return b.Step(StepRest, end, NoTarget())
+}
+
+// Cooldown adds a cooldown step.
+func (b *WorkoutBuilder) Cooldown(end WorkoutEnd, target WorkoutTarget) *WorkoutBuilder {
+	return b.Step(StepCooldown, end, target)
+}
+
+// Repeat adds a repeat group. The steps to repeat are added by fn.
+func (b *WorkoutBuilder) Repeat(iterations int, fn func(r *WorkoutBuilder)) *WorkoutBuilder {
+	r := &WorkoutBuilder{}
+	fn(r)
+
+	b.steps = append(b.steps, WorkoutStep{
+		Type:              stepTypeRepeat,
+		StepType:          StepRepeat,
+		EndCondition:      EndIterations,
+		EndConditionValue: float64(iterations),
+		Iterations:        iterations,
+		Steps:             r.steps,
+	})
+
+	return b
+}
+
+// Build returns the workout. Steps are numbered in the order Garmin Connect
+// expects.
+func (b *WorkoutBuilder) Build() *Workout {
+	workout := b.workout
+
+	steps := make([]WorkoutStep, len(b.steps))
+	copy(steps, b.steps)
+
+	order := 0
+	numberSteps(steps, &order)
+
+	workout.Segments = []WorkoutSegment{
+		{
+			Order:     1,
+			SportType: workout.SportType,
+			Steps:     steps,
+		},
+	}
+
+	var duration time.Duration
+	for i := range steps {
+		duration += steps[i].Duration()
+	}
+	workout.EstimatedDuration = int(duration.Seconds())
+
+	return &workout
+}
+
+// numberSteps will number steps depth-first.
+func numberSteps(steps []WorkoutStep, order *int) {
+	for i := range steps {
+		*order++
+		steps[i].Order = *order
+
+		if len(steps[i].Steps) > 0 {
+			children := make([]WorkoutStep, len(steps[i].Steps))
+			copy(children, steps[i].Steps)
+			numberSteps(children, order)
+
+			steps[i].Steps = children
+		}
+	}
+}
@@ -0,0 +1,53 @@
+package connect
+
+import (
+	"testing"
+	"time"
+)
+
+func TestWorkoutBuilder(t *testing.T) {
+	workout := NewWorkoutBuilder("5x3min", SportRunning).
+		Warmup(ForDuration(10*time.Minute), HeartRateZone(2)).
+		Repeat(5, func(r *WorkoutBuilder) {
+			r.Interval(ForDuration(3*time.Minute), PaceRange(4*time.Minute+10*time.Second, 4*time.Minute))
+			r.Recovery(ForDuration(2*time.Minute), NoTarget())
+		}).
+		Cooldown(UntilLapButton(), NoTarget()).
+		Build()
+
+	if len(workout.Segments) != 1 {
+		t.Fatalf("Expected 1 segment, got %d", len(workout.Segments))
+	}
+
+	steps := workout.Segments[0].Steps
+	if len(steps) != 3 {
+		t.Fatalf("Expected 3 steps, got %d", len(steps))
+	}
+
+	repeat := steps[1]
+	if !repeat.IsRepeat() || repeat.Iterations != 5 || len(repeat.Steps) != 2 {
+		t.Fatalf("Expected repeat group with 2 steps and 5 iterations, got %+v", repeat)
+	}
+
+	// Steps are numbered depth-first.
+	orders := []int{steps[0].Order, repeat.Order, repeat.Steps[0].Order, repeat.Steps[1].Order, steps[2].Order}
+	for i, order := range orders {
+		if order != i+1 {
+			t.Errorf("Expected step order %d, got %d", i+1, order)
+		}
+	}
+
+	if repeat.Duration() != 25*time.Minute {
+		t.Errorf("Expected repeat duration 25m, got %s", repeat.Duration())
+	}
+
+	interval := repeat.Steps[0]
+	if *interval.TargetType != TargetPace || interval.TargetValueOne >= interval.TargetValueTwo {
+		t.Errorf("Expected pace target with slow speed first, got %+v", interval)
+	}
+
+	// The cooldown has no fixed duration.
+	if workout.EstimatedDuration != int((35 * time.Minute).Seconds()) {
+		t.Errorf("Expected estimated duration 35m, got %ds", workout.EstimatedDuration)
+	}
+}
@@ -0,0 +1,171 @@
+package connect
+
+import (
+	"io"
+	"math"
+	"time"
+)
+
+// FIT enum values used for workouts.
+const (
+	fitDurationTime     = 0
+	fitDurationDistance = 1
+	fitDurationCalories = 4
+	fitDurationOpen     = 5
+	fitDurationRepeat   = 6
+
+	fitTargetSpeed     = 0
+	fitTargetHeartRate = 1
+	fitTargetOpen      = 2
+	fitTargetCadence   = 3
+	fitTargetPower     = 4
+
+	fitIntensityActive   = 0
+	fitIntensityRest     = 1
+	fitIntensityWarmup   = 2
+	fitIntensityCooldown = 3
+	fitIntensityRecovery = 4
+	fitIntensityInterval = 5
+	fitIntensityOther    = 6
+)
+
+// WriteFIT will write workout as a FIT workout file to w. The file can be
+// copied to the NewFiles folder of a device.
+func (w *Workout) WriteFIT(writer io.Writer) error {
+	var steps []WorkoutStep
+	for _, s := range w.Segments {
+		steps = append(steps, s.Steps...)
+	}
+
+	e := newFitEncoder()
+
+	// file_id. Type 5 is workout and manufacturer 255 is development.
+	e.message(0,
+		fitValue(0, fitEnum, 5),
+		fitValue(1, fitUint16, 255),
+		fitValue(2, fitUint16, 0),
+		fitValue(3, fitUint32z, 1),
+		fitValue(4, fitUint32, fitTime(time.Now())),
+	)
+
+	// Repeat groups add steps, so we do a dry run to count them.
+	count := writeFITSteps(newFitEncoder(), steps, 0)
+
+	// workout.
+	e.message(26,
+		fitValue(4, fitEnum, fitSport(w.SportType)),
+		fitValue(6, fitUint16, int64(count)),
+		fitText(8, w.Name, 32),
+	)
+
+	writeFITSteps(e, steps, 0)
+
+	return e.writeTo(writer)
+}
+
+// writeFITSteps will write steps as workout_step messages starting at
+// index. Repeat groups are written after the steps they repeat, as
+// required by FIT. The index of the next step is returned.
+func writeFITSteps(e *fitEncoder, steps []WorkoutStep, index int) int {
+	for _, s := range steps {
+		if s.IsRepeat() {
+			first := index
+			index = writeFITSteps(e, s.Steps, index)
+
+			writeFITStep(e, index, s.Description, fitDurationRepeat, int64(first), fitTargetOpen, int64(s.Iterations), 0, 0, fitIntensityActive)
+			index++
+
+			continue
+		}
+
+		durationType, durationValue := int64(fitDurationOpen), int64(0)
+		switch s.EndCondition {
+		case EndTime:
+			durationType, durationValue = fitDurationTime, int64(math.Round(s.EndConditionValue*1000.0))
+		case EndDistance:
+			durationType, durationValue = fitDurationDistance, int64(math.Round(s.EndConditionValue*100.0))
+		case EndCalories:
+			durationType, durationValue = fitDurationCalories, int64(math.Round(s.EndConditionValue))
+		}
+
+		targetType, targetValue, low, high := int64(fitTargetOpen), int64(0), int64(0), int64(0)
+		if s.TargetType != nil {
+			switch *s.TargetType {
+			case TargetHeartRate:
+				// Custom heart rates are offset by 100 to tell them from zones.
+				targetType, targetValue = fitTargetHeartRate, int64(s.ZoneNumber)
+				if s.ZoneNumber == 0 {
+					low, high = int64(s.TargetValueOne)+100, int64(s.TargetValueTwo)+100
+				}
+			case TargetPower:
+				// Custom power is offset by 1000 to tell it from zones.
+				targetType, targetValue = fitTargetPower, int64(s.ZoneNumber)
+				if s.ZoneNumber == 0 {
+					low, high = int64(s.TargetValueOne)+1000, int64(s.TargetValueTwo)+1000
+				}
+			case TargetPace, TargetSpeed:
+				targetType = fitTargetSpeed
+				low, high = int64(math.Round(s.TargetValueOne*1000.0)), int64(math.Round(s.TargetValueTwo*1000.0))
+			case TargetCadence:
+				targetType = fitTargetCadence
+				low, high = int64(s.TargetValueOne), int64(s.TargetValueTwo)
+			}
+		}
+
+		writeFITStep(e, index, s.Description, durationType, durationValue, targetType, targetValue, low, high, fitIntensity(s.StepType))
+		index++
+	}
+
+	return index
+}
+
+// writeFITStep will write a single workout_step message.
+func writeFITStep(e *fitEncoder, index int, name string, durationType int64, durationValue int64, targetType int64, targetValue int64, low int64, high int64, intensity int64) {
+	e.message(27,
+		fitValue(254, fitUint16, int64(index)),
+		fitText(0, name, 16),
+		fitValue(1, fitEnum, durationType),
+		fitValue(2, fitUint32, durationValue),
+		fitValue(3, fitEnum, targetType),
+		fitValue(4, fitUint32, targetValue),
+		fitValue(5, fitUint32, low),
+		fitValue(6, fitUint32, high),
+		fitValue(7, fitEnum, intensity),
+	)
+}
+
+// fitIntensity returns the FIT intensity of a step type.
+func fitIntensity(t WorkoutStepType) int64 {
+	switch t {
+	case StepWarmup:
+		return fitIntensityWarmup
+	case StepCooldown:
+		return fitIntensityCooldown
+	case StepInterval:
+		return fitIntensityInterval
+	case StepRecovery:
+		return fitIntensityRecovery
+	case StepRest:
+		return fitIntensityRest
+	case StepOther:
+		return fitIntensityOther
+	}
+
+	return fitIntensityActive
+}
+
+// fitSport returns the FIT sport of a sport type.
+func fitSport(s WorkoutSportType) int64 {
+	switch s {
+	case SportRunning:
+		return 1
+	case SportCycling:
+		return 2
+	case SportSwimming:
+		return 5
+	case SportStrengthTraining, SportCardioTraining, SportYoga, SportPilates, SportHIIT:
+		return 10
+	}
+
+	return 0
+}
@@ -0,0 +1,170 @@
+package connect
+
+import (
+	"bytes"
+	"encoding/binary"
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+)
+
+// fitMessage is a decoded FIT data message.
+type fitMessage struct {
+	global uint16
+	fields map[byte][]byte
+}
+
+// decodeFIT is a minimal FIT decoder used to verify the encoder.
+func decodeFIT(t *testing.T, data []byte) []fitMessage {
+	if len(data) < 16 || string(data[8:12]) != ".FIT" {
+		t.Fatalf("Missing FIT header")
+	}
+
+	if fitCRC(0, data) != 0 {
+		t.Fatalf("File checksum mismatch")
+	}
+
+	type definition struct {
+		global uint16
+		fields [][2]byte
+	}
+
+	definitions := make(map[byte]definition)
+
+	var messages []fitMessage
+
+	body := data[14 : len(data)-2]
+	for pos := 0; pos < len(body); {
+		header := body[pos]
+		local := header & 0x0f
+		pos++
+
+		if header&0x40 != 0 {
+			d := definition{global: binary.LittleEndian.Uint16(body[pos+2:])}
+			n := int(body[pos+4])
+			pos += 5
+
+			for i := 0; i < n; i++ {
+				d.fields = append(d.fields, [2]byte{body[pos], body[pos+1]})
+				pos += 3
+			}
+
+			definitions[local] = d
+
+			continue
+		}
+
+		d, found := definitions[local]
+		if !found {
+			t.Fatalf("Data message for undefined local type %d", local)
+		}
+
+		m := fitMessage{global: d.global, fields: make(map[byte][]byte)}
+		for _, f := range d.fields {
+			m.fields[f[0]] = body[pos : pos+int(f[1])]
+			pos += int(f[1])
+		}
+
+		messages = append(messages, m)
+	}
+
+	return messages
+}
+
+func (m fitMessage) uint(num byte) uint32 {
+	v := m.fields[num]
+	switch len(v) {
+	case 1:
+		return uint32(v[0])
+	case 2:
+		return uint32(binary.LittleEndian.Uint16(v))
+	default:
+		return binary.LittleEndian.Uint32(v)
+	}
+}
+
+func (m fitMessage) text(num byte) string {
+	return string(bytes.TrimRight(m.fields[num], "\x00"))
+}
+
+func TestWorkoutWriteFIT(t *testing.T) {
+	name := "Intervaltræning på løbeba 5×3 min"
+
+	workout := NewWorkoutBuilder(name, SportRunning).
+		Warmup(ForDuration(10*time.Minute), NoTarget()).
+		Repeat(5, func(r *WorkoutBuilder) {
+			r.Interval(ForDistance(800.0), HeartRateRange(150, 160))
+			r.Recovery(ForDuration(2*time.Minute), HeartRateZone(2))
+		}).
+		Interval(ForCalories(300.0), NoTarget()).
+		Cooldown(UntilLapButton(), NoTarget()).
+		Build()
+
+	buffer := bytes.NewBuffer(nil)
+
+	err := workout.WriteFIT(buffer)
+	if err != nil {
+		t.Fatalf("WriteFIT() returned %s", err.Error())
+	}
+
+	messages := decodeFIT(t, buffer.Bytes())
+	if len(messages) != 8 {
+		t.Fatalf("Expected 8 messages, got %d", len(messages))
+	}
+
+	if messages[0].global != 0 || messages[0].uint(0) != 5 {
+		t.Errorf("Expected file_id of type workout first")
+	}
+
+	w := messages[1]
+	if w.global != 26 || w.uint(4) != 1 || w.uint(6) != 6 {
+		t.Errorf("Expected running workout with 6 steps, got sport %d and %d steps", w.uint(4), w.uint(6))
+	}
+
+	// The name must be truncated without splitting a rune.
+	truncated := w.text(8)
+	if len(truncated) > 31 || !utf8.ValidString(truncated) || !strings.HasPrefix(name, truncated) {
+		t.Errorf("Expected a valid prefix of the name, got '%s'", truncated)
+	}
+
+	expected := []struct {
+		durationType  uint32
+		durationValue uint32
+		targetType    uint32
+		targetValue   uint32
+		low           uint32
+		intensity     uint32
+	}{
+		{fitDurationTime, 600000, fitTargetOpen, 0, 0, fitIntensityWarmup},
+		{fitDurationDistance, 80000, fitTargetHeartRate, 0, 250, fitIntensityInterval},
+		{fitDurationTime, 120000, fitTargetHeartRate, 2, 0, fitIntensityRecovery},
+		{fitDurationRepeat, 1, fitTargetOpen, 5, 0, fitIntensityActive},
+		{fitDurationCalories, 300, fitTargetOpen, 0, 0, fitIntensityInterval},
+		{fitDurationOpen, 0, fitTargetOpen, 0, 0, fitIntensityCooldown},
+	}
+
+	for i, e := range expected {
+		s := messages[i+2]
+		if s.global != 27 || s.uint(254) != uint32(i) {
+			t.Fatalf("Expected workout_step %d, got message %d with index %d", i, s.global, s.uint(254))
+		}
+
+		if s.uint(1) != e.durationType || s.uint(2) != e.durationValue {
+			t.Errorf("Step %d: expected duration %d/%d, got %d/%d", i, e.durationType, e.durationValue, s.uint(1), s.uint(2))
+		}
+
+		if s.uint(3) != e.targetType || s.uint(4) != e.targetValue || s.uint(5) != e.low {
+			t.Errorf("Step %d: expected target %d/%d/%d, got %d/%d/%d", i, e.targetType, e.targetValue, e.low, s.uint(3), s.uint(4), s.uint(5))
+		}
+
+		if s.uint(7) != e.intensity {
+			t.Errorf("Step %d: expected intensity %d, got %d", i, e.intensity, s.uint(7))
+		}
+	}
+
+	// Calories is 4 in the FIT profile, 2 would be a heart rate condition.
+	if duration := messages[6].fields[1]; len(duration) != 1 || duration[0] != 4 {
+		t.Errorf("Expected calorie step to have duration_type 4, got %v", duration)
+	}
+}
@@ -0,0 +1,262 @@
+package connect
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	workoutRepeatRegexp   = regexp.MustCompile(`^(\d+)\s*x\s*\((.*)\)$`)
+	workoutEndRegexp      = regexp.MustCompile(`^(\d+(?:\.\d+)?)(h|min|s|sec|km|m|mi)$`)
+	workoutZoneRegexp     = regexp.MustCompile(`^(hr|p|power)?\s*z(\d)$`)
+	workoutRangeRegexp    = regexp.MustCompile(`^(\d+)(?:-(\d+))?\s*(bpm|w|rpm)$`)
+	workoutPaceRegexp     = regexp.MustCompile(`^(\d+):(\d{2})(?:-(\d+):(\d{2}))?/(km|mi)$`)
+	workoutStepTypeByWord = map[string]WorkoutStepType{
+		"warmup":   StepWarmup,
+		"wu":       StepWarmup,
+		"cooldown": StepCooldown,
+		"cd":       StepCooldown,
+		"interval": StepInterval,
+		"hard":     StepInterval,
+		"recovery": StepRecovery,
+		"easy":     StepRecovery,
+		"jog":      StepRecovery,
+		"rest":     StepRest,
+		"other":    StepOther,
+	}
+)
+
+// WorkoutSportTypes lists all known sport types.
+var WorkoutSportTypes = []WorkoutSportType{
+	SportRunning,
+	SportCycling,
+	SportOther,
+	SportSwimming,
+	SportStrengthTraining,
+	SportCardioTraining,
+	SportYoga,
+	SportPilates,
+	SportHIIT,
+}
+
+// ParseWorkoutSport will return the sport type with the key given.
+func ParseWorkoutSport(key string) (WorkoutSportType, error) {
+	for _, s := range WorkoutSportTypes {
+		if s.Key == key {
+			return s, nil
+		}
+	}
+
+	return WorkoutSportType{}, fmt.Errorf("unknown sport '%s'", key)
+}
+
+// ParseWorkout will build a workout from a textual description of the
+// steps. Steps are separated by semicolon or comma, and each step consists
+// of an end condition, an optional step type and an optional target:
+//
+//	10min warmup @Z2; 5x(3min @4:00/km, 2min easy); lap cooldown
+//
+// End conditions can be a duration (90s, 10min, 1h), a distance (400m,
+// 5km, 1mi) or "lap" for the lap button. Targets can be a heart rate zone
+// (Z2), a power zone (PZ3), a pace (4:00/km or 4:00-4:10/km) or a range of
+// bpm, W or rpm (140-150bpm, 250W). Steps without a type are intervals.
+func ParseWorkout(name string, sport WorkoutSportType, text string) (*Workout, error) {
+	b := NewWorkoutBuilder(name, sport)
+
+	err := parseWorkoutSteps(b, text)
+	if err != nil {
+		return nil, err
+	}
+
+	return b.Build(), nil
+}
+
+// parseWorkoutSteps will add all steps in text to b.
+func parseWorkoutSteps(b *WorkoutBuilder, text string) error {
+	for _, part := range splitWorkoutSteps(text) {
+		part = strings.ToLower(strings.TrimSpace(part))
+		if part == "" {
+			continue
+		}
+
+		if m := workoutRepeatRegexp.FindStringSubmatch(part); m != nil {
+			iterations, _ := strconv.Atoi(m[1])
+
+			var err error
+			b.Repeat(iterations, func(r *WorkoutBuilder) {
+				err = parseWorkoutSteps(r, m[2])
+			})
+			if err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		err := parseWorkoutStep(b, part)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// splitWorkoutSteps will split text at separators outside parentheses.
+func splitWorkoutSteps(text string) []string {
+	var parts []string
+
+	depth, start := 0, 0
+	for i, r := range text {
+		switch r {
+		case '(':
+			depth++
+		case ')':
+			depth--
+		case ';', ',':
+			if depth == 0 {
+				parts = append(parts, text[start:i])
+				start = i + 1
+			}
+		}
+	}
+
+	return append(parts, text[start:])
+}
+
+// parseWorkoutStep will add a single step to b.
+func parseWorkoutStep(b *WorkoutBuilder, text string) error {
+	target := NoTarget()
+
+	if i := strings.Index(text, "@"); i >= 0 {
+		var err error
+		target, err = parseWorkoutTarget(strings.TrimSpace(text[i+1:]))
+		if err != nil {
+			return err
+		}
+
+		text = text[:i]
+	}
+
+	stepType := StepInterval
+	end := ""
+	for _, word := range strings.Fields(text) {
+		if t, found := workoutStepTypeByWord[word]; found {
+			stepType = t
+			continue
+		}
+
+		end += word
+	}
+
+	if end == "" {
+		return fmt.Errorf("no duration or distance in step '%s'", text)
+	}
+
+	if end == "lap" {
+		b.Step(stepType, UntilLapButton(), target)
+
+		return nil
+	}
+
+	m := workoutEndRegexp.FindStringSubmatch(end)
+	if m == nil {
+		return fmt.Errorf("unable to parse duration or distance '%s'", end)
+	}
+
+	value, _ := strconv.ParseFloat(m[1], 64)
+
+	switch m[2] {
+	case "h":
+		b.Step(stepType, ForDuration(time.Duration(value*float64(time.Hour))), target)
+	case "min":
+		b.Step(stepType, ForDuration(time.Duration(value*float64(time.Minute))), target)
+	case "s", "sec":
+		b.Step(stepType, ForDuration(time.Duration(value*float64(time.Second))), target)
+	case "km":
+		b.Step(stepType, ForDistance(value*1000.0), target)
+	case "m":
+		b.Step(stepType, ForDistance(value), target)
+	case "mi":
+		b.Step(stepType, ForDistance(value*1609.344), target)
+	}
+
+	return nil
+}
+
+// parseWorkoutTarget will parse the target of a step. Single values are
+// widened to a small range.
+func parseWorkoutTarget(text string) (WorkoutTarget, error) {
+	if m := workoutZoneRegexp.FindStringSubmatch(text); m != nil {
+		zone, _ := strconv.Atoi(m[2])
+
+		if m[1] == "p" || m[1] == "power" {
+			return PowerZone(zone), nil
+		}
+
+		return HeartRateZone(zone), nil
+	}
+
+	if m := workoutRangeRegexp.FindStringSubmatch(text); m != nil {
+		low, _ := strconv.Atoi(m[1])
+		high := low
+		if m[2] != "" {
+			high, _ = strconv.Atoi(m[2])
+		}
+
+		switch m[3] {
+		case "bpm":
+			if low == high {
+				low, high = low-5, high+5
+			}
+
+			return HeartRateRange(low, high), nil
+		case "w":
+			if low == high {
+				low, high = low*95/100, high*105/100
+			}
+
+			return PowerRange(low, high), nil
+		case "rpm":
+			if low == high {
+				low, high = low-5, high+5
+			}
+
+			return CadenceRange(low, high), nil
+		}
+	}
+
+	if m := workoutPaceRegexp.FindStringSubmatch(text); m != nil {
+		pace := func(minutes string, seconds string) time.Duration {
+			min, _ := strconv.Atoi(minutes)
+			sec, _ := strconv.Atoi(seconds)
+
+			return time.Duration(min)*time.Minute + time.Duration(sec)*time.Second
+		}
+
+		fast := pace(m[1], m[2])
+		slow := fast
+		if m[3] != "" {
+			slow = pace(m[3], m[4])
+		} else {
+			fast, slow = fast-5*time.Second, slow+5*time.Second
+		}
+
+		if fast > slow {
+			fast, slow = slow, fast
+		}
+
+		// PaceRange expects paces per kilometer.
+		if m[5] == "mi" {
+			fast = time.Duration(float64(fast) / 1.609344)
+			slow = time.Duration(float64(slow) / 1.609344)
+		}
+
+		return PaceRange(slow, fast), nil
+	}
+
+	return WorkoutTarget{}, fmt.Errorf("unable to parse target '%s'", text)
+}
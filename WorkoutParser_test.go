@@ -0,0 +1,56 @@
+package connect
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseWorkout(t *testing.T) {
+	workout, err := ParseWorkout("test", SportRunning, "10min warmup @Z2; 5x(3min @4:00/km, 2min easy); 400m @250W, lap cooldown")
+	if err != nil {
+		t.Fatalf("Failed to parse workout: %s", err.Error())
+	}
+
+	steps := workout.Segments[0].Steps
+	if len(steps) != 4 {
+		t.Fatalf("Expected 4 steps, got %d", len(steps))
+	}
+
+	if steps[0].StepType != StepWarmup || steps[0].Duration() != 10*time.Minute || steps[0].ZoneNumber != 2 {
+		t.Errorf("Wrong warmup step: %+v", steps[0])
+	}
+
+	repeat := steps[1]
+	if !repeat.IsRepeat() || repeat.Iterations != 5 || len(repeat.Steps) != 2 {
+		t.Fatalf("Wrong repeat step: %+v", repeat)
+	}
+
+	if repeat.Steps[0].StepType != StepInterval || *repeat.Steps[0].TargetType != TargetPace {
+		t.Errorf("Wrong interval step: %+v", repeat.Steps[0])
+	}
+
+	if repeat.Steps[1].StepType != StepRecovery || *repeat.Steps[1].TargetType != TargetNone {
+		t.Errorf("Wrong recovery step: %+v", repeat.Steps[1])
+	}
+
+	if steps[2].EndCondition != EndDistance || steps[2].EndConditionValue != 400.0 || steps[2].TargetValueTwo <= steps[2].TargetValueOne {
+		t.Errorf("Wrong distance step: %+v", steps[2])
+	}
+
+	if steps[3].StepType != StepCooldown || steps[3].EndCondition != EndLapButton {
+		t.Errorf("Wrong cooldown step: %+v", steps[3])
+	}
+
+	invalid := []string{
+		"warmup",
+		"10 parsecs",
+		"10min @fast",
+	}
+
+	for _, text := range invalid {
+		_, err = ParseWorkout("test", SportRunning, text)
+		if err == nil {
+			t.Errorf("Expected error for '%s'", text)
+		}
+	}
+}
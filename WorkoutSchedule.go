@@ -0,0 +1,90 @@
+package connect
+
+import (
+	"fmt"
+	"sort"
+	"time"
+)
+
+// ScheduledWorkout is a workout scheduled on the calendar.
+type ScheduledWorkout struct {
+	ID      int64   `json:"workoutScheduleId"`
+	Date    Date    `json:"calendarDate"`
+	Workout Workout `json:"workout"`
+}
+
+// ScheduleWorkout will put a workout from the workout library on the
+// calendar at date.
+func (c *Client) ScheduleWorkout(workoutID int64, date time.Time) (*ScheduledWorkout, error) {
+	URL := fmt.Sprintf("https://connect.garmin.com/modern/proxy/workout-service/schedule/%d", workoutID)
+
+	if !c.authenticated() {
+		return nil, ErrNotAuthenticated
+	}
+
+	payload := struct {
+		Date Date `json:"date"`
+	}{NewDate(date)}
+
+	scheduled := new(ScheduledWorkout)
+
+	err := c.writeJSON("POST", URL, payload, scheduled)
+	if err != nil {
+		return nil, err
+	}
+
+	return scheduled, nil
+}
+
+// MoveScheduledWorkout will move a scheduled workout to date. scheduleID is
+// the ID of the calendar item.
+func (c *Client) MoveScheduledWorkout(scheduleID int64, date time.Time) error {
+	URL := fmt.Sprintf("https://connect.garmin.com/modern/proxy/workout-service/schedule/%d", scheduleID)
+
+	if !c.authenticated() {
+		return ErrNotAuthenticated
+	}
+
+	payload := struct {
+		ID   int64 `json:"workoutScheduleId"`
+		Date Date  `json:"calendarDate"`
+	}{scheduleID, NewDate(date)}
+
+	return c.write("PUT", URL, payload, 204)
+}
+
+// UnscheduleWorkout will remove a scheduled workout from the calendar. The
+// workout will be kept in the workout library.
+func (c *Client) UnscheduleWorkout(scheduleID int64) error {
+	URL := fmt.Sprintf("https://connect.garmin.com/modern/proxy/workout-service/schedule/%d", scheduleID)
+
+	if !c.authenticated() {
+		return ErrNotAuthenticated
+	}
+
+	return c.write("DELETE", URL, nil, 204)
+}
+
+// UpcomingWorkouts will list workouts scheduled from today and the
+// following days. The list is sorted by date.
+func (c *Client) UpcomingWorkouts(days int) ([]CalendarItem, error) {
+	now := time.Now()
+
+	items, err := c.CalendarItems(now, now.AddDate(0, 0, days))
+	if err != nil {
+		return nil, err
+	}
+
+	var workouts []CalendarItem
+	for _, item := range items {
+		if item.ItemType == CalendarItemWorkout {
+			workouts = append(workouts, item)
+		}
+	}
+
+	sort.SliceStable(workouts, func(i, j int) bool {
+		return workouts[i].Date.Time().Before(workouts[j].Date.Time())
+	})
+
+	return workouts, nil
+}
@@ -0,0 +1,114 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"strings"
+)
+
+// Chart is a simple ASCII line chart. Each series is plotted with its own
+// symbol, NaN values are not plotted.
+type Chart struct {
+	width  int
+	height int
+	series []chartSeries
+}
+
+type chartSeries struct {
+	symbol rune
+	values []float64
+}
+
+// NewChart returns a chart of the given size in characters, not counting
+// the axis.
+func NewChart(width int, height int) *Chart {
+	return &Chart{
+		width:  width,
+		height: height,
+	}
+}
+
+// AddSeries adds a series of values. Later series are drawn on top.
+func (c *Chart) AddSeries(symbol rune, values []float64) {
+	c.series = append(c.series, chartSeries{symbol, values})
+}
+
+// compress will average values into at most width columns.
+func compress(values []float64, width int) []float64 {
+	if len(values) <= width {
+		return values
+	}
+
+	result := make([]float64, width)
+	for i := range result {
+		from := i * len(values) / width
+		to := (i + 1) * len(values) / width
+
+		sum, count := 0.0, 0
+		for _, v := range values[from:to] {
+			if !math.IsNaN(v) {
+				sum += v
+				count++
+			}
+		}
+
+		result[i] = math.NaN()
+		if count > 0 {
+			result[i] = sum / float64(count)
+		}
+	}
+
+	return result
+}
+
+func (c *Chart) Output(writer io.Writer) {
+	min, max := math.Inf(1), math.Inf(-1)
+	columns := 0
+
+	series := make([][]float64, len(c.series))
+	for i, s := range c.series {
+		series[i] = compress(s.values, c.width)
+
+		for _, v := range series[i] {
+			if !math.IsNaN(v) {
+				min = math.Min(min, v)
+				max = math.Max(max, v)
+			}
+		}
+
+		if len(series[i]) > columns {
+			columns = len(series[i])
+		}
+	}
+
+	if math.IsInf(min, 0) {
+		return
+	}
+
+	if max == min {
+		max = min + 1.0
+	}
+
+	grid := make([][]rune, c.height)
+	for y := range grid {
+		grid[y] = []rune(strings.Repeat(" ", columns))
+	}
+
+	for i, s := range c.series {
+		for x, v := range series[i] {
+			if math.IsNaN(v) {
+				continue
+			}
+
+			y := int(math.Round((max - v) / (max - min) * float64(c.height-1)))
+			grid[y][x] = s.symbol
+		}
+	}
+
+	for y, row := range grid {
+		label := max - float64(y)*(max-min)/float64(c.height-1)
+		fmt.Fprintf(writer, "%8.1f ┤%s\n", label, string(row))
+	}
+	fmt.Fprintf(writer, "%8s └%s\n", "", strings.Repeat("─", columns))
+}
@@ -0,0 +1,161 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	connect "github.com/abrander/garmin-connect"
+)
+
+var (
+	bpTime  string
+	bpNotes string
+)
+
+func init() {
+	bpCmd := &cobra.Command{
+		Use: "bp",
+	}
+	rootCmd.AddCommand(bpCmd)
+
+	bpLatestCmd := &cobra.Command{
+		Use:   "latest",
+		Short: "Show the latest blood pressure reading within the last month",
+		Run:   bpLatest,
+		Args:  cobra.NoArgs,
+	}
+	bpCmd.AddCommand(bpLatestCmd)
+
+	bpAddCmd := &cobra.Command{
+		Use:   "add <yyyy-mm-dd> <systolic> <diastolic> [pulse]",
+		Short: "Add a blood pressure reading",
+		Run:   bpAdd,
+		Args:  cobra.RangeArgs(3, 4),
+	}
+	bpAddCmd.Flags().StringVar(&bpTime, "time", "", "Local time of day of the reading (hh:mm), defaults to now")
+	bpAddCmd.Flags().StringVar(&bpNotes, "notes", "", "Notes for the reading")
+	bpCmd.AddCommand(bpAddCmd)
+
+	bpDeleteCmd := &cobra.Command{
+		Use:   "delete <yyyy-mm-dd>",
+		Short: "Delete a blood pressure reading, asks which one if there is more than one",
+		Run:   bpDelete,
+		Args:  cobra.ExactArgs(1),
+	}
+	bpCmd.AddCommand(bpDeleteCmd)
+
+	bpRangeCmd := &cobra.Command{
+		Use:   "range <yyyy-mm-dd> <yyyy-mm-dd>",
+		Short: "Show blood pressure readings for a date range",
+		Run:   bpRange,
+		Args:  cobra.ExactArgs(2),
+	}
+	bpCmd.AddCommand(bpRangeCmd)
+}
+
+func bpLatest(_ *cobra.Command, _ []string) {
+	now := time.Now()
+
+	readings, err := client.BloodPressureRange(now.AddDate(0, -1, 0), now)
+	bail(err)
+
+	if len(readings) == 0 {
+		fmt.Printf("No blood pressure readings found\n")
+		os.Exit(1)
+	}
+
+	latest := readings[0]
+
+	t := NewTabular()
+	t.AddValue("Time", latest.TimestampLocal.Format("2006-01-02 15:04"))
+	t.AddValueUnit("Systolic", latest.Systolic, "mmHg")
+	t.AddValueUnit("Diastolic", latest.Diastolic, "mmHg")
+	t.AddValueUnit("Pulse", latest.Pulse, "bpm")
+	t.AddValue("Category", latest.Category)
+	t.AddValue("Notes", latest.Notes)
+	t.Output(os.Stdout)
+}
+
+func bpAdd(_ *cobra.Command, args []string) {
+	date, err := connect.ParseDate(args[0])
+	bail(err)
+
+	values := make([]int, 3)
+	for i, arg := range args[1:] {
+		values[i], err = strconv.Atoi(arg)
+		bail(err)
+	}
+
+	now := time.Now()
+	timestamp := time.Date(date.Year, date.Month, date.DayOfMonth, now.Hour(), now.Minute(), 0, 0, time.Local)
+	if bpTime != "" {
+		clock, err := time.Parse("15:04", bpTime)
+		bail(err)
+
+		timestamp = time.Date(date.Year, date.Month, date.DayOfMonth, clock.Hour(), clock.Minute(), 0, 0, time.Local)
+	}
+
+	err = client.AddBloodPressure(timestamp, values[0], values[1], values[2], bpNotes)
+	bail(err)
+}
+
+func bpDelete(_ *cobra.Command, args []string) {
+	date, err := connect.ParseDate(args[0])
+	bail(err)
+
+	readings, err := client.BloodPressureRange(date.Time(), date.Time())
+	bail(err)
+
+	switch len(readings) {
+	case 0:
+		fmt.Printf("No blood pressure readings on this date\n")
+		os.Exit(1)
+	case 1:
+		bail(client.DeleteBloodPressure(readings[0]))
+		return
+	}
+
+	outputBloodPressure(readings)
+
+	fmt.Printf("\nReading to delete (1-%d): ", len(readings))
+
+	var choice int
+	_, err = fmt.Scanln(&choice)
+	bail(err)
+
+	if choice < 1 || choice > len(readings) {
+		bail(fmt.Errorf("no reading #%d", choice))
+	}
+
+	bail(client.DeleteBloodPressure(readings[choice-1]))
+}
+
+func bpRange(_ *cobra.Command, args []string) {
+	from, to := dateRangeArgs(args)
+
+	readings, err := client.BloodPressureRange(from, to)
+	bail(err)
+
+	outputBloodPressure(readings)
+}
+
+func outputBloodPressure(readings []connect.BloodPressure) {
+	t := NewTable()
+	t.AddHeader("#", "Time", "Systolic", "Diastolic", "Pulse", "Category", "Notes")
+	for i, r := range readings {
+		t.AddRow(
+			i+1,
+			r.TimestampLocal.Format("2006-01-02 15:04"),
+			r.Systolic,
+			r.Diastolic,
+			r.Pulse,
+			r.Category,
+			r.Notes,
+		)
+	}
+	t.Output(os.Stdout)
+}
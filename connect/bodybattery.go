@@ -0,0 +1,77 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	connect "github.com/abrander/garmin-connect"
+)
+
+func init() {
+	bodyBatteryCmd := &cobra.Command{
+		Use:   "bodybattery <yyyy-mm-dd>",
+		Short: "Show body battery for a date",
+		Run:   bodyBattery,
+		Args:  cobra.ExactArgs(1),
+	}
+	rootCmd.AddCommand(bodyBatteryCmd)
+}
+
+func bodyBattery(_ *cobra.Command, args []string) {
+	date, err := connect.ParseDate(args[0])
+	bail(err)
+
+	reports, err := client.BodyBattery(date.Time(), date.Time())
+	bail(err)
+
+	if len(reports) < 1 {
+		fmt.Printf("No body battery data on this date\n")
+		os.Exit(1)
+	}
+
+	report := reports[0]
+
+	t := NewTabular()
+	t.AddValue("Date", report.Date)
+	t.AddValue("Charged", report.Charged)
+	t.AddValue("Drained", report.Drained)
+	t.AddValue("High", report.High)
+	t.AddValue("Low", report.Low)
+	t.Output(os.Stdout)
+
+	if len(report.Values) > 0 {
+		// One character for every 15 minutes starting at local midnight.
+		first := report.Values[0].Timestamp
+		midnight := time.Date(first.Year(), first.Month(), first.Day(), 0, 0, 0, 0, first.Location())
+		values := report.Values.Resample(midnight, 15*time.Minute, 96)
+
+		fmt.Fprintf(os.Stdout, "\n    %s\n", sparkline(values, 0.0, 100.0))
+		fmt.Fprintf(os.Stdout, "    %-24s%-24s%-24s%-24s\n", "00", "06", "12", "18")
+	}
+
+	events, err := client.BodyBatteryEvents(date.Time())
+	bail(err)
+
+	if len(events) == 0 {
+		return
+	}
+
+	fmt.Fprintf(os.Stdout, "\n")
+
+	t2 := NewTable()
+	t2.AddHeader("Start", "Type", "Duration", "Impact", "Feedback", "Activity")
+	for _, e := range events {
+		t2.AddRow(
+			e.Start.Format("15:04"),
+			e.Type,
+			hoursAndMinutes(e.Duration),
+			fmt.Sprintf("%+d", e.Impact),
+			e.ShortFeedback,
+			e.ActivityName,
+		)
+	}
+	t2.Output(os.Stdout)
+}
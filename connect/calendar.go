@@ -1,10 +1,20 @@
 package main
 
 import (
+	"fmt"
 	"os"
 	"strconv"
+	"time"
 
 	"github.com/spf13/cobra"
+
+	connect "github.com/abrander/garmin-connect"
+)
+
+var (
+	calendarUpcomingDays int
+	calendarICSFrom      string
+	calendarICSTo        string
 )
 
 func init() {
@@ -37,6 +47,48 @@ func init() {
 	}
 	calendarCmd.AddCommand(calendarWeekCmd)
 
+	calendarScheduleCmd := &cobra.Command{
+		Use:   "schedule <workout id> <yyyy-mm-dd>",
+		Short: "Schedule a workout on a date",
+		Run:   calendarSchedule,
+		Args:  cobra.ExactArgs(2),
+	}
+	calendarCmd.AddCommand(calendarScheduleCmd)
+
+	calendarMoveCmd := &cobra.Command{
+		Use:   "move <schedule id> <yyyy-mm-dd>",
+		Short: "Move a scheduled workout to another date",
+		Run:   calendarMove,
+		Args:  cobra.ExactArgs(2),
+	}
+	calendarCmd.AddCommand(calendarMoveCmd)
+
+	calendarUnscheduleCmd := &cobra.Command{
+		Use:   "unschedule <schedule id>",
+		Short: "Remove a scheduled workout from the calendar",
+		Run:   calendarUnschedule,
+		Args:  cobra.ExactArgs(1),
+	}
+	calendarCmd.AddCommand(calendarUnscheduleCmd)
+
+	calendarUpcomingCmd := &cobra.Command{
+		Use:   "upcoming",
+		Short: "List upcoming scheduled workouts",
+		Run:   calendarUpcoming,
+		Args:  cobra.NoArgs,
+	}
+	calendarUpcomingCmd.Flags().IntVar(&calendarUpcomingDays, "days", 28, "Number of days to look ahead")
+	calendarCmd.AddCommand(calendarUpcomingCmd)
+
+	calendarICSCmd := &cobra.Command{
+		Use:   "ics",
+		Short: "Export the calendar as iCalendar to stdout",
+		Run:   calendarICS,
+		Args:  cobra.NoArgs,
+	}
+	calendarICSCmd.Flags().StringVar(&calendarICSFrom, "from", "", "First date to export (yyyy-mm-dd), defaults to 30 days ago")
+	calendarICSCmd.Flags().StringVar(&calendarICSTo, "to", "", "Last date to export (yyyy-mm-dd), defaults to 90 days ahead")
+	calendarCmd.AddCommand(calendarICSCmd)
 }
 
 func calendarYear(_ *cobra.Command, args []string) {
@@ -71,11 +123,12 @@ func calendarMonth(_ *cobra.Command, args []string) {
 	bail(err)
 
 	t := NewTable()
-	t.AddHeader("ID", "Date", "Name", "Distance", "Time", "Calories")
+	t.AddHeader("ID", "Date", "Type", "Name", "Distance", "Time", "Calories")
 	for _, item := range calendar.CalendarItems {
 		t.AddRow(
 			item.ID,
 			item.Date,
+			item.ItemType,
 			item.Title,
 			item.Distance,
 			item.ElapsedDuration,
@@ -99,11 +152,12 @@ func calendarWeek(_ *cobra.Command, args []string) {
 	bail(err)
 
 	t := NewTable()
-	t.AddHeader("ID", "Date", "Name", "Distance", "Time", "Calories")
+	t.AddHeader("ID", "Date", "Type", "Name", "Distance", "Time", "Calories")
 	for _, item := range calendar.CalendarItems {
 		t.AddRow(
 			item.ID,
 			item.Date,
+			item.ItemType,
 			item.Title,
 			item.Distance,
 			item.ElapsedDuration,
@@ -112,3 +166,61 @@ func calendarWeek(_ *cobra.Command, args []string) {
 	}
 	t.Output(os.Stdout)
 }
+
+func calendarSchedule(_ *cobra.Command, args []string) {
+	workoutID := idArg(args[0])
+
+	date, err := connect.ParseDate(args[1])
+	bail(err)
+
+	scheduled, err := client.ScheduleWorkout(workoutID, date.Time())
+	bail(err)
+
+	fmt.Printf("Workout scheduled with schedule ID %d\n", scheduled.ID)
+}
+
+func calendarMove(_ *cobra.Command, args []string) {
+	scheduleID := idArg(args[0])
+
+	date, err := connect.ParseDate(args[1])
+	bail(err)
+
+	err = client.MoveScheduledWorkout(scheduleID, date.Time())
+	bail(err)
+}
+
+func calendarUnschedule(_ *cobra.Command, args []string) {
+	scheduleID := idArg(args[0])
+
+	err := client.UnscheduleWorkout(scheduleID)
+	bail(err)
+}
+
+func calendarUpcoming(_ *cobra.Command, _ []string) {
+	workouts, err := client.UpcomingWorkouts(calendarUpcomingDays)
+	bail(err)
+
+	t := NewTable()
+	t.AddHeader("Schedule ID", "Date", "Workout ID", "Name")
+	for _, item := range workouts {
+		t.AddRow(
+			item.ID,
+			item.Date,
+			item.WorkoutID,
+			item.Title,
+		)
+	}
+	t.Output(os.Stdout)
+}
+
+func calendarICS(_ *cobra.Command, _ []string) {
+	now := time.Now()
+	from := parseDateFlag(calendarICSFrom, now.AddDate(0, 0, -30))
+	to := parseDateFlag(calendarICSTo, now.AddDate(0, 0, 90))
+
+	items, err := client.CalendarItems(from, to)
+	bail(err)
+
+	err = connect.WriteICS(os.Stdout, items)
+	bail(err)
+}
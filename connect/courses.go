@@ -0,0 +1,137 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	connect "github.com/abrander/garmin-connect"
+)
+
+var (
+	coursesExportFormat string
+	coursesImportName   string
+)
+
+func init() {
+	coursesCmd := &cobra.Command{
+		Use: "courses",
+	}
+	rootCmd.AddCommand(coursesCmd)
+
+	coursesListCmd := &cobra.Command{
+		Use:   "list",
+		Short: "List courses",
+		Run:   coursesList,
+		Args:  cobra.NoArgs,
+	}
+	coursesCmd.AddCommand(coursesListCmd)
+
+	coursesViewCmd := &cobra.Command{
+		Use:   "view <course id>",
+		Short: "Show details about a course",
+		Run:   coursesView,
+		Args:  cobra.ExactArgs(1),
+	}
+	coursesCmd.AddCommand(coursesViewCmd)
+
+	coursesDeleteCmd := &cobra.Command{
+		Use:   "delete <course id>",
+		Short: "Delete a course",
+		Run:   coursesDelete,
+		Args:  cobra.ExactArgs(1),
+	}
+	coursesCmd.AddCommand(coursesDeleteCmd)
+
+	coursesExportCmd := &cobra.Command{
+		Use:   "export <course id>",
+		Short: "Export a course to a file",
+		Run:   coursesExport,
+		Args:  cobra.ExactArgs(1),
+	}
+	coursesExportCmd.Flags().StringVarP(&coursesExportFormat, "format", "f", "gpx", "Format of export (gpx, fit)")
+	coursesCmd.AddCommand(coursesExportCmd)
+
+	coursesImportCmd := &cobra.Command{
+		Use:   "import <file>",
+		Short: "Create a course from a GPX or FIT file",
+		Run:   coursesImport,
+		Args:  cobra.ExactArgs(1),
+	}
+	coursesImportCmd.Flags().StringVar(&coursesImportName, "name", "", "Name of the course, defaults to the name in the file")
+	coursesCmd.AddCommand(coursesImportCmd)
+}
+
+func coursesList(_ *cobra.Command, _ []string) {
+	courses, err := client.Courses()
+	bail(err)
+
+	t := NewTable()
+	t.AddHeader("ID", "Name", "Type", "Distance", "Gain", "Loss")
+	for _, c := range courses {
+		t.AddRow(
+			c.ID,
+			c.Name,
+			c.ActivityType.TypeKey,
+			c.Distance/1000.0,
+			c.ElevationGain,
+			c.ElevationLoss,
+		)
+	}
+	t.Output(os.Stdout)
+}
+
+func coursesView(_ *cobra.Command, args []string) {
+	course, err := client.Course(idArg(args[0]))
+	bail(err)
+
+	t := NewTabular()
+	t.AddValue("ID", course.ID)
+	t.AddValue("Name", course.Name)
+	t.AddValue("Description", course.Description)
+	t.AddValue("Type", course.ActivityType.TypeKey)
+	t.AddValueUnit("Distance", course.Distance/1000.0, "km")
+	t.AddValueUnit("Elevation Gain", course.ElevationGain, "m")
+	t.AddValueUnit("Elevation Loss", course.ElevationLoss, "m")
+	t.AddValue("Created", course.Created)
+	t.Output(os.Stdout)
+}
+
+func coursesDelete(_ *cobra.Command, args []string) {
+	err := client.DeleteCourse(idArg(args[0]))
+	bail(err)
+}
+
+func coursesExport(_ *cobra.Command, args []string) {
+	format, err := connect.FormatFromExtension(coursesExportFormat)
+	bail(err)
+
+	id := idArg(args[0])
+
+	// The course is buffered to avoid leaving an empty file on errors.
+	buffer := bytes.NewBuffer(nil)
+
+	err = client.ExportCourse(id, buffer, format)
+	bail(err)
+
+	name := fmt.Sprintf("%d.%s", id, format.Extension())
+	err = ioutil.WriteFile(name, buffer.Bytes(), 0644)
+	bail(err)
+}
+
+func coursesImport(_ *cobra.Command, args []string) {
+	filename := args[0]
+
+	f, err := os.Open(filename)
+	bail(err)
+	defer f.Close()
+
+	course, err := client.ImportCourse(f, filepath.Base(filename), coursesImportName)
+	bail(err)
+
+	fmt.Printf("Course ID %d created\n", course.ID)
+}
@@ -0,0 +1,122 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	devicesCmd := &cobra.Command{
+		Use: "devices",
+	}
+	rootCmd.AddCommand(devicesCmd)
+
+	devicesListCmd := &cobra.Command{
+		Use:   "list",
+		Short: "List registered devices",
+		Run:   devicesList,
+		Args:  cobra.NoArgs,
+	}
+	devicesCmd.AddCommand(devicesListCmd)
+
+	devicesSettingsCmd := &cobra.Command{
+		Use:   "settings <device id> [setting]",
+		Short: "Show all settings of a device or a single setting",
+		Run:   devicesSettings,
+		Args:  cobra.RangeArgs(1, 2),
+	}
+	devicesCmd.AddCommand(devicesSettingsCmd)
+
+	devicesSetCmd := &cobra.Command{
+		Use:   "set <device id> <setting> <value>",
+		Short: "Change a device setting, value is given as JSON",
+		Run:   devicesSet,
+		Args:  cobra.ExactArgs(3),
+	}
+	devicesCmd.AddCommand(devicesSetCmd)
+}
+
+func devicesList(_ *cobra.Command, _ []string) {
+	devices, err := client.Devices()
+	bail(err)
+
+	t := NewTable()
+	t.AddHeader("ID", "Name", "Serial", "Firmware", "Battery", "Status", "Last Sync")
+	for _, d := range devices {
+		name := d.DisplayName
+		if name == "" {
+			name = d.ProductName
+		}
+
+		battery := d.BatteryStatus
+		if d.BatteryLevel > 0 {
+			battery = fmt.Sprintf("%d%%", d.BatteryLevel)
+		}
+
+		t.AddRow(
+			d.ID,
+			name,
+			d.SerialNumber,
+			d.Firmware,
+			battery,
+			d.Status,
+			formatDate(d.LastSync.Time),
+		)
+	}
+	t.Output(os.Stdout)
+}
+
+func devicesSettings(_ *cobra.Command, args []string) {
+	deviceID := idArg(args[0])
+
+	settings, err := client.DeviceSettings(deviceID)
+	bail(err)
+
+	if len(args) > 1 {
+		value, found := settings.Get(args[1])
+		if !found {
+			bail(fmt.Errorf("unknown setting '%s'", args[1]))
+		}
+
+		b, err := json.MarshalIndent(value, "", "  ")
+		bail(err)
+
+		fmt.Printf("%s\n", b)
+
+		return
+	}
+
+	t := NewTable()
+	t.AddHeader("Setting", "Value")
+	for _, key := range settings.Keys() {
+		value, _ := settings.Get(key)
+
+		b, err := json.Marshal(value)
+		bail(err)
+
+		t.AddRow(key, string(b))
+	}
+	t.Output(os.Stdout)
+}
+
+func devicesSet(_ *cobra.Command, args []string) {
+	deviceID := idArg(args[0])
+
+	settings, err := client.DeviceSettings(deviceID)
+	bail(err)
+
+	// Values that are not valid JSON are treated as strings.
+	var value interface{}
+	if json.Unmarshal([]byte(args[2]), &value) != nil {
+		value = args[2]
+	}
+
+	err = settings.Set(args[1], value)
+	bail(err)
+
+	err = client.UpdateDeviceSettings(settings)
+	bail(err)
+}
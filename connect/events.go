@@ -0,0 +1,223 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	connect "github.com/abrander/garmin-connect"
+)
+
+var (
+	eventsFrom     string
+	eventsType     string
+	eventsDistance string
+	eventsGoal     string
+	eventsLocation string
+	eventsTime     string
+	eventsPrimary  bool
+	eventsTraining bool
+)
+
+func init() {
+	eventsCmd := &cobra.Command{
+		Use: "events",
+	}
+	rootCmd.AddCommand(eventsCmd)
+
+	eventsListCmd := &cobra.Command{
+		Use:   "list",
+		Short: "List upcoming events",
+		Run:   eventsList,
+		Args:  cobra.NoArgs,
+	}
+	eventsListCmd.Flags().StringVar(&eventsFrom, "from", "", "First date to list (yyyy-mm-dd), defaults to today")
+	eventsCmd.AddCommand(eventsListCmd)
+
+	eventsAddCmd := &cobra.Command{
+		Use:   "add <yyyy-mm-dd> <name>",
+		Short: "Add an event",
+		Run:   eventsAdd,
+		Args:  cobra.ExactArgs(2),
+	}
+	addEventFlags(eventsAddCmd)
+	eventsCmd.AddCommand(eventsAddCmd)
+
+	eventsEditCmd := &cobra.Command{
+		Use:   "edit <event id>",
+		Short: "Change an event, only flags given are changed",
+		Run:   eventsEdit,
+		Args:  cobra.ExactArgs(1),
+	}
+	addEventFlags(eventsEditCmd)
+	eventsCmd.AddCommand(eventsEditCmd)
+
+	eventsDeleteCmd := &cobra.Command{
+		Use:   "delete <event id>",
+		Short: "Delete an event",
+		Run:   eventsDelete,
+		Args:  cobra.ExactArgs(1),
+	}
+	eventsCmd.AddCommand(eventsDeleteCmd)
+}
+
+// addEventFlags adds the flags describing an event to cmd.
+func addEventFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&eventsType, "type", "running", "Sport of the event")
+	cmd.Flags().StringVar(&eventsDistance, "distance", "", "Distance like 10km, 5000m or 13.1mi")
+	cmd.Flags().StringVar(&eventsGoal, "goal", "", "Goal time as h:mm:ss")
+	cmd.Flags().StringVar(&eventsLocation, "location", "", "Location of the event")
+	cmd.Flags().StringVar(&eventsTime, "time", "", "Local start time (hh:mm)")
+	cmd.Flags().BoolVar(&eventsPrimary, "primary", false, "Primary event to train for")
+	cmd.Flags().BoolVar(&eventsTraining, "training", false, "Training event, not a race")
+}
+
+// parseDistance will parse a distance with a unit and return it in meters.
+func parseDistance(value string) (float64, error) {
+	units := []struct {
+		suffix string
+		meters float64
+	}{
+		{"km", 1000.0},
+		{"mi", 1609.344},
+		{"m", 1.0},
+	}
+
+	value = strings.ToLower(strings.TrimSpace(value))
+
+	for _, u := range units {
+		if !strings.HasSuffix(value, u.suffix) {
+			continue
+		}
+
+		f, err := strconv.ParseFloat(strings.TrimSpace(strings.TrimSuffix(value, u.suffix)), 64)
+		if err != nil {
+			break
+		}
+
+		return f * u.meters, nil
+	}
+
+	return 0.0, fmt.Errorf("unable to parse distance '%s'", value)
+}
+
+// parseClockDuration will parse a duration given as h:mm:ss or mm:ss.
+func parseClockDuration(value string) (time.Duration, error) {
+	var d time.Duration
+
+	for _, part := range strings.Split(value, ":") {
+		n, err := strconv.Atoi(part)
+		if err != nil {
+			return 0, fmt.Errorf("unable to parse duration '%s'", value)
+		}
+
+		d = d*60 + time.Duration(n)*time.Second
+	}
+
+	return d, nil
+}
+
+// applyEventFlags will set the fields of event given by flags.
+func applyEventFlags(cmd *cobra.Command, event *connect.Event) {
+	flags := cmd.Flags()
+
+	if flags.Changed("type") || event.Type == "" {
+		event.Type = eventsType
+	}
+
+	if flags.Changed("distance") {
+		distance, err := parseDistance(eventsDistance)
+		bail(err)
+
+		event.Distance = distance
+	}
+
+	if flags.Changed("goal") {
+		goal, err := parseClockDuration(eventsGoal)
+		bail(err)
+
+		event.GoalTime = goal
+	}
+
+	if flags.Changed("location") {
+		event.Location = eventsLocation
+	}
+
+	if flags.Changed("time") {
+		_, err := time.Parse("15:04", eventsTime)
+		bail(err)
+
+		event.StartTime = eventsTime
+	}
+
+	if flags.Changed("primary") {
+		event.Primary = eventsPrimary
+	}
+
+	if flags.Changed("training") {
+		event.Training = eventsTraining
+	}
+}
+
+func eventsList(_ *cobra.Command, _ []string) {
+	from := parseDateFlag(eventsFrom, time.Now())
+
+	events, err := client.Events(from)
+	bail(err)
+
+	t := NewTable()
+	t.AddHeader("ID", "Date", "Name", "Type", "Distance", "Goal", "Primary", "Location")
+	for _, e := range events {
+		t.AddRow(
+			e.ID,
+			e.Date,
+			e.Name,
+			e.Type,
+			nzf(e.Distance/1000.0),
+			hoursAndMinutes(e.GoalTime),
+			e.Primary,
+			e.Location,
+		)
+	}
+	t.Output(os.Stdout)
+}
+
+func eventsAdd(cmd *cobra.Command, args []string) {
+	date, err := connect.ParseDate(args[0])
+	bail(err)
+
+	event := &connect.Event{
+		Name: args[1],
+		Date: date,
+	}
+
+	applyEventFlags(cmd, event)
+
+	created, err := client.CreateEvent(event)
+	bail(err)
+
+	fmt.Printf("Event ID %d created\n", created.ID)
+}
+
+func eventsEdit(cmd *cobra.Command, args []string) {
+	id := idArg(args[0])
+
+	event, err := client.Event(id)
+	bail(err)
+
+	applyEventFlags(cmd, event)
+
+	err = client.UpdateEvent(event)
+	bail(err)
+}
+
+func eventsDelete(_ *cobra.Command, args []string) {
+	id := idArg(args[0])
+
+	err := client.DeleteEvent(id)
+	bail(err)
+}
@@ -1,17 +1,32 @@
 package main
 
 import (
+	"fmt"
 	"os"
 	"sort"
 	"strconv"
+	"time"
 
 	"github.com/spf13/cobra"
+
+	connect "github.com/abrander/garmin-connect"
 )
 
-func init() {
-	gearCmd := &cobra.Command{
+var (
+	gearCmd = &cobra.Command{
 		Use: "gear",
 	}
+
+	gearMake        string
+	gearModel       string
+	gearMaxDistance float64
+	gearBegin       string
+	gearEnd         string
+	gearName        string
+	gearRetireDate  string
+)
+
+func init() {
 	rootCmd.AddCommand(gearCmd)
 
 	gearListCmd := &cobra.Command{
@@ -52,6 +67,101 @@ func init() {
 		Args:  cobra.ExactArgs(1),
 	}
 	gearCmd.AddCommand(gearForActivityCommand)
+
+	gearAddCmd := &cobra.Command{
+		Use:   "add <type> <name>",
+		Short: "Add Gear, type is a name from 'gear types'",
+		Run:   gearAdd,
+		Args:  cobra.ExactArgs(2),
+	}
+	addGearFlags(gearAddCmd)
+	gearCmd.AddCommand(gearAddCmd)
+
+	gearEditCmd := &cobra.Command{
+		Use:   "edit <gear UUID>",
+		Short: "Edit Gear, only flags given are changed",
+		Run:   gearEdit,
+		Args:  cobra.ExactArgs(1),
+	}
+	addGearFlags(gearEditCmd)
+	gearEditCmd.Flags().StringVar(&gearName, "name", "", "Nickname")
+	gearCmd.AddCommand(gearEditCmd)
+
+	gearRetireCmd := &cobra.Command{
+		Use:   "retire <gear UUID>",
+		Short: "Retire Gear",
+		Run:   gearRetire,
+		Args:  cobra.ExactArgs(1),
+	}
+	gearRetireCmd.Flags().StringVar(&gearRetireDate, "date", "", "Date of retirement (yyyy-mm-dd), defaults to today")
+	gearCmd.AddCommand(gearRetireCmd)
+
+	gearDeleteCmd := &cobra.Command{
+		Use:   "delete <gear UUID>",
+		Short: "Delete Gear permanently",
+		Run:   gearDelete,
+		Args:  cobra.ExactArgs(1),
+	}
+	gearCmd.AddCommand(gearDeleteCmd)
+}
+
+// addGearFlags adds the flags describing gear to cmd.
+func addGearFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&gearMake, "make", "", "Brand")
+	cmd.Flags().StringVar(&gearModel, "model", "", "Model")
+	cmd.Flags().Float64Var(&gearMaxDistance, "max-distance", 0.0, "Maximum distance in km before retirement")
+	cmd.Flags().StringVar(&gearBegin, "begin", "", "First date of use (yyyy-mm-dd)")
+	cmd.Flags().StringVar(&gearEnd, "end", "", "Last date of use (yyyy-mm-dd), empty to clear")
+}
+
+// applyGearFlags will set the fields of gear given by flags.
+func applyGearFlags(cmd *cobra.Command, gear *connect.Gear) {
+	flags := cmd.Flags()
+
+	if flags.Changed("name") {
+		gear.DisplayName = gearName
+	}
+
+	if flags.Changed("make") {
+		gear.GearMakeName = gearMake
+	}
+
+	if flags.Changed("model") {
+		gear.GearModelName = gearModel
+	}
+
+	if flags.Changed("make") || flags.Changed("model") {
+		gear.CustomMakeModel = gear.GearMakeName + " " + gear.GearModelName
+	}
+
+	if flags.Changed("max-distance") {
+		gear.MaximumMeters = gearMaxDistance * 1000.0
+	}
+
+	if flags.Changed("begin") {
+		gear.DateBegin = connect.Time{Time: parseDateFlag(gearBegin, time.Time{})}
+	}
+
+	if flags.Changed("end") {
+		gear.DateEnd = connect.Time{Time: parseDateFlag(gearEnd, time.Time{})}
+	}
+}
+
+// gearByUUID will find the gear with uuid among the gear of the
+// authenticated user.
+func gearByUUID(uuid string) connect.Gear {
+	gear, err := client.Gear(0)
+	bail(err)
+
+	for _, g := range gear {
+		if g.Uuid == uuid {
+			return g
+		}
+	}
+
+	bail(fmt.Errorf("gear %s not found", uuid))
+
+	return connect.Gear{}
 }
 
 func gearList(_ *cobra.Command, args []string) {
@@ -65,7 +175,7 @@ func gearList(_ *cobra.Command, args []string) {
 	bail(err)
 
 	t := NewTable()
-	t.AddHeader("UUID", "Type", "Brand & Model", "Nickname", "Created Date", "Total Distance", "Activities")
+	t.AddHeader("UUID", "Type", "Brand & Model", "Nickname", "Status", "Created Date", "Total Distance", "Activities", "Used")
 	for _, g := range gear {
 
 		gearStats, err := client.GearStats(g.Uuid)
@@ -76,14 +186,27 @@ func gearList(_ *cobra.Command, args []string) {
 			g.GearTypeName,
 			g.CustomMakeModel,
 			g.DisplayName,
+			g.GearStatusName,
 			g.CreateDate.Time,
 			strconv.FormatFloat(gearStats.TotalDistance, 'f', 2, 64),
 			gearStats.TotalActivities,
+			gearUsed(g, gearStats),
 		)
 	}
 	t.Output(os.Stdout)
 }
 
+// gearUsed returns the percentage of the maximum distance used by gear.
+func gearUsed(gear connect.Gear, stats *connect.GearStats) string {
+	if gear.MaximumMeters <= 0.0 {
+		return "-"
+	}
+
+	usage := connect.NewGearUsage(gear, *stats, nil, 0, time.Now())
+
+	return fmt.Sprintf("%.0f%%", usage.UsedPercent)
+}
+
 func gearTypeList(_ *cobra.Command, _ []string) {
 	gearTypes, err := client.GearType()
 	bail(err)
@@ -149,3 +272,38 @@ func gearForActivity(_ *cobra.Command, args []string) {
 	}
 	t.Output(os.Stdout)
 }
+
+func gearAdd(cmd *cobra.Command, args []string) {
+	gear := connect.Gear{
+		GearTypeName: args[0],
+		DisplayName:  args[1],
+	}
+
+	applyGearFlags(cmd, &gear)
+
+	created, err := client.CreateGear(gear)
+	bail(err)
+
+	fmt.Printf("Gear %s created\n", created.Uuid)
+}
+
+func gearEdit(cmd *cobra.Command, args []string) {
+	gear := gearByUUID(args[0])
+
+	applyGearFlags(cmd, &gear)
+
+	err := client.UpdateGear(gear)
+	bail(err)
+}
+
+func gearRetire(_ *cobra.Command, args []string) {
+	gear := gearByUUID(args[0])
+
+	err := client.RetireGear(gear, parseDateFlag(gearRetireDate, time.Now()))
+	bail(err)
+}
+
+func gearDelete(_ *cobra.Command, args []string) {
+	err := client.DeleteGear(args[0])
+	bail(err)
+}
@@ -0,0 +1,227 @@
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"regexp"
+	"strconv"
+	"time"
+
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v2"
+
+	connect "github.com/abrander/garmin-connect"
+)
+
+// gearRuleFile is a single rule in a rules file. Distances are in km.
+type gearRuleFile struct {
+	Gear        string  `yaml:"gear"`
+	Type        string  `yaml:"type"`
+	MinDistance float64 `yaml:"min-distance"`
+	MaxDistance float64 `yaml:"max-distance"`
+	Name        string  `yaml:"name"`
+}
+
+var (
+	gearAutolinkSince  string
+	gearAutolinkRules  string
+	gearAutolinkDryRun bool
+)
+
+func init() {
+	gearDefaultsCmd := &cobra.Command{
+		Use:   "defaults",
+		Short: "List default Gear per activity type",
+		Run:   gearDefaults,
+		Args:  cobra.NoArgs,
+	}
+	gearCmd.AddCommand(gearDefaultsCmd)
+
+	gearDefaultsSetCmd := &cobra.Command{
+		Use:   "set <gear UUID> <activity type id>",
+		Short: "Make Gear the default for an activity type",
+		Run:   gearDefaultsSet,
+		Args:  cobra.ExactArgs(2),
+	}
+	gearDefaultsCmd.AddCommand(gearDefaultsSetCmd)
+
+	gearDefaultsRemoveCmd := &cobra.Command{
+		Use:   "remove <gear UUID> <activity type id>",
+		Short: "Remove Gear as default for an activity type",
+		Run:   gearDefaultsRemove,
+		Args:  cobra.ExactArgs(2),
+	}
+	gearDefaultsCmd.AddCommand(gearDefaultsRemoveCmd)
+
+	gearAutolinkCmd := &cobra.Command{
+		Use:   "autolink",
+		Short: "Link Gear to activities without Gear using rules or defaults",
+		Run:   gearAutolink,
+		Args:  cobra.NoArgs,
+	}
+	gearAutolinkCmd.Flags().StringVar(&gearAutolinkSince, "since", "", "First date to consider (yyyy-mm-dd), defaults to 30 days ago")
+	gearAutolinkCmd.Flags().StringVar(&gearAutolinkRules, "rules", "", "YAML file with rules, the default Gear per activity type is used if not given")
+	gearAutolinkCmd.Flags().BoolVar(&gearAutolinkDryRun, "dry-run", false, "Only show what would be linked")
+	gearCmd.AddCommand(gearAutolinkCmd)
+}
+
+func gearDefaults(_ *cobra.Command, _ []string) {
+	defaults, err := client.GearDefaults()
+	bail(err)
+
+	t := NewTable()
+	t.AddHeader("UUID", "Activity Type ID")
+	for _, d := range defaults {
+		t.AddRow(d.GearUUID, d.ActivityTypeID)
+	}
+	t.Output(os.Stdout)
+}
+
+func gearDefaultsSet(_ *cobra.Command, args []string) {
+	activityTypeID, err := strconv.Atoi(args[1])
+	bail(err)
+
+	err = client.SetGearDefault(args[0], activityTypeID)
+	bail(err)
+}
+
+func gearDefaultsRemove(_ *cobra.Command, args []string) {
+	activityTypeID, err := strconv.Atoi(args[1])
+	bail(err)
+
+	err = client.RemoveGearDefault(args[0], activityTypeID)
+	bail(err)
+}
+
+// readGearRules will read rules from a YAML file.
+func readGearRules(filename string) ([]connect.GearRule, error) {
+	data, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	var file []gearRuleFile
+
+	err = yaml.UnmarshalStrict(data, &file)
+	if err != nil {
+		return nil, err
+	}
+
+	rules := make([]connect.GearRule, len(file))
+	for i, r := range file {
+		if r.Gear == "" {
+			return nil, fmt.Errorf("%s: rule %d has no gear", filename, i+1)
+		}
+
+		rules[i] = connect.GearRule{
+			GearUUID:     r.Gear,
+			ActivityType: r.Type,
+			MinDistance:  r.MinDistance * 1000.0,
+			MaxDistance:  r.MaxDistance * 1000.0,
+		}
+
+		if r.Name != "" {
+			rules[i].Name, err = regexp.Compile(r.Name)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return rules, nil
+}
+
+func gearAutolink(_ *cobra.Command, _ []string) {
+	now := time.Now()
+	since := parseDateFlag(gearAutolinkSince, now.AddDate(0, 0, -30))
+
+	var rules []connect.GearRule
+
+	if gearAutolinkRules != "" {
+		var err error
+		rules, err = readGearRules(gearAutolinkRules)
+		bail(err)
+	} else {
+		defaults, err := client.GearDefaults()
+		bail(err)
+
+		for _, d := range defaults {
+			rules = append(rules, connect.GearRule{
+				GearUUID:       d.GearUUID,
+				ActivityTypeID: d.ActivityTypeID,
+			})
+		}
+	}
+
+	// Retired gear should not be used for new activities.
+	gear, err := client.Gear(0)
+	bail(err)
+
+	retired := make(map[string]bool)
+	for _, g := range gear {
+		if g.GearStatusName == connect.GearStatusRetired {
+			retired[g.Uuid] = true
+		}
+	}
+
+	active := rules[:0]
+	for _, rule := range rules {
+		if retired[rule.GearUUID] {
+			fmt.Fprintf(os.Stderr, "Skipping rule for retired gear %s\n", rule.GearUUID)
+			continue
+		}
+
+		active = append(active, rule)
+	}
+	rules = active
+
+	activities, err := client.ActivitiesRange(since, now)
+	bail(err)
+
+	failed := 0
+
+	t := NewTable()
+	t.AddHeader("Activity ID", "Date", "Name", "Type", "Distance", "Gear", "Action")
+	for i := range activities {
+		a := &activities[i]
+
+		rule := connect.MatchGearRule(rules, a)
+		if rule == nil {
+			continue
+		}
+
+		// Errors are reported per activity, to keep a record of what
+		// was already linked.
+		linked, err := client.GearForActivity(0, a.ID)
+
+		action := "link"
+		if err == nil && len(linked) > 0 {
+			action = "skip"
+		}
+
+		if err == nil && action == "link" && !gearAutolinkDryRun {
+			err = client.GearLink(rule.GearUUID, a.ID)
+		}
+
+		if err != nil {
+			action = "error: " + err.Error()
+			failed++
+		}
+
+		t.AddRow(
+			a.ID,
+			formatDate(a.StartLocal.Time),
+			a.ActivityName,
+			a.ActivityType.TypeKey,
+			a.Distance/1000.0,
+			rule.GearUUID,
+			action,
+		)
+	}
+	t.Output(os.Stdout)
+
+	if failed > 0 {
+		bail(fmt.Errorf("%d activities could not be linked", failed))
+	}
+}
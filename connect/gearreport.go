@@ -0,0 +1,98 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	connect "github.com/abrander/garmin-connect"
+)
+
+var (
+	gearReportThreshold float64
+	gearReportWeeks     int
+)
+
+func init() {
+	gearReportCmd := &cobra.Command{
+		Use:   "report",
+		Short: "Show Gear usage and projected retirement, exits with 2 if any Gear exceeds the threshold",
+		Run:   gearReport,
+		Args:  cobra.NoArgs,
+	}
+	gearReportCmd.Flags().Float64Var(&gearReportThreshold, "threshold", 90.0, "Alert when this percentage of the maximum distance is used")
+	gearReportCmd.Flags().IntVar(&gearReportWeeks, "weeks", 8, "Number of recent weeks used for the weekly distance")
+	gearCmd.AddCommand(gearReportCmd)
+}
+
+// recentGearActivities will list activities with gear since cutoff.
+func recentGearActivities(uuid string, cutoff time.Time) []connect.Activity {
+	const pageSize = 100
+
+	var activities []connect.Activity
+
+	for start := 0; ; start += pageSize {
+		page, err := client.GearActivities(uuid, start, pageSize)
+		bail(err)
+
+		activities = append(activities, page...)
+
+		if len(page) < pageSize || page[len(page)-1].StartLocal.Before(cutoff) {
+			break
+		}
+	}
+
+	return activities
+}
+
+func gearReport(_ *cobra.Command, _ []string) {
+	gear, err := client.Gear(0)
+	bail(err)
+
+	now := time.Now()
+	cutoff := now.AddDate(0, 0, -7*gearReportWeeks)
+
+	var alerts []string
+
+	t := NewTable()
+	t.AddHeader("UUID", "Nickname", "Status", "Distance", "Activities", "Maximum", "Used", "Weekly", "Retirement")
+	for _, g := range gear {
+		stats, err := client.GearStats(g.Uuid)
+		bail(err)
+
+		usage := connect.NewGearUsage(g, *stats, recentGearActivities(g.Uuid, cutoff), gearReportWeeks, now)
+
+		used := "-"
+		if g.MaximumMeters > 0.0 {
+			used = fmt.Sprintf("%.0f%%", usage.UsedPercent)
+		}
+
+		if g.GearStatusName != connect.GearStatusRetired && g.MaximumMeters > 0.0 && usage.UsedPercent >= gearReportThreshold {
+			alerts = append(alerts, fmt.Sprintf("%s (%s) has used %.0f%% of %.0f km", g.DisplayName, g.Uuid, usage.UsedPercent, g.MaximumMeters/1000.0))
+		}
+
+		t.AddRow(
+			g.Uuid,
+			g.DisplayName,
+			g.GearStatusName,
+			usage.TotalDistance/1000.0,
+			usage.TotalActivities,
+			nzf(g.MaximumMeters/1000.0),
+			used,
+			nzf(usage.WeeklyDistance/1000.0),
+			formatDate(usage.Retirement),
+		)
+	}
+	t.Output(os.Stdout)
+
+	if len(alerts) > 0 {
+		fmt.Fprintf(os.Stderr, "\n")
+		for _, alert := range alerts {
+			fmt.Fprintf(os.Stderr, "ALERT: %s\n", alert)
+		}
+
+		exitCode = exitAlert
+	}
+}
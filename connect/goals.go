@@ -1,10 +1,22 @@
 package main
 
 import (
+	"fmt"
 	"os"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
+
+	connect "github.com/abrander/garmin-connect"
+)
+
+var (
+	goalsStart     string
+	goalsEnd       string
+	goalsDays      int
+	goalsWeekStart string
 )
 
 func init() {
@@ -21,6 +33,26 @@ func init() {
 	}
 	goalsCmd.AddCommand(goalsListCmd)
 
+	goalsAddCmd := &cobra.Command{
+		Use:   "add <type> <value>",
+		Short: "Add a goal of type " + goalTypeNames(),
+		Run:   goalsAdd,
+		Args:  cobra.ExactArgs(2),
+	}
+	goalsAddCmd.Flags().StringVar(&goalsStart, "start", "", "Start date of the goal (yyyy-mm-dd), defaults to today")
+	goalsAddCmd.Flags().StringVar(&goalsEnd, "end", "", "End date of the goal (yyyy-mm-dd)")
+	goalsCmd.AddCommand(goalsAddCmd)
+
+	goalsUpdateCmd := &cobra.Command{
+		Use:   "update <goal id> <value>",
+		Short: "Update the value of a goal",
+		Run:   goalsUpdate,
+		Args:  cobra.ExactArgs(2),
+	}
+	goalsUpdateCmd.Flags().StringVar(&goalsStart, "start", "", "Start date of the goal (yyyy-mm-dd)")
+	goalsUpdateCmd.Flags().StringVar(&goalsEnd, "end", "", "End date of the goal (yyyy-mm-dd)")
+	goalsCmd.AddCommand(goalsUpdateCmd)
+
 	goalsDeleteCmd := &cobra.Command{
 		Use:   "delete <goal id>",
 		Short: "Delete a goal",
@@ -28,6 +60,38 @@ func init() {
 		Args:  cobra.ExactArgs(1),
 	}
 	goalsCmd.AddCommand(goalsDeleteCmd)
+
+	goalsProgressCmd := &cobra.Command{
+		Use:   "progress [display name]",
+		Short: "Show how goals have been met recently",
+		Run:   goalsProgress,
+		Args:  cobra.RangeArgs(0, 1),
+	}
+	goalsProgressCmd.Flags().IntVar(&goalsDays, "days", 30, "Number of days to include")
+	goalsProgressCmd.Flags().StringVar(&goalsWeekStart, "week-start", "monday", "First day of the week for weekly goals")
+	goalsCmd.AddCommand(goalsProgressCmd)
+}
+
+func goalTypeNames() string {
+	names := make([]string, len(connect.GoalTypes))
+	for i, t := range connect.GoalTypes {
+		names[i] = t.String()
+	}
+
+	return strings.Join(names, ", ")
+}
+
+// allGoals will retrieve goals of all known types.
+func allGoals(displayName string) []connect.Goal {
+	var all []connect.Goal
+	for _, typ := range connect.GoalTypes {
+		goals, err := client.Goals(displayName, int(typ))
+		bail(err)
+
+		all = append(all, goals...)
+	}
+
+	return all
 }
 
 func goalsList(_ *cobra.Command, args []string) {
@@ -38,30 +102,206 @@ func goalsList(_ *cobra.Command, args []string) {
 
 	t := NewTable()
 	t.AddHeader("ID", "Profile", "Category", "Type", "Start", "End", "Created", "Value")
-	for typ := 0; typ <= 9; typ++ {
-		goals, err := client.Goals(displayName, typ)
-		bail(err)
+	for _, g := range allGoals(displayName) {
+		t.AddRow(
+			g.ID,
+			g.ProfileID,
+			g.GoalCategory,
+			g.GoalType,
+			g.Start,
+			g.End,
+			g.Created,
+			g.Value,
+		)
+	}
+	t.Output(os.Stdout)
+}
 
-		for _, g := range goals {
-			t.AddRow(
-				g.ID,
-				g.ProfileID,
-				g.GoalCategory,
-				g.GoalType,
-				g.Start,
-				g.End,
-				g.Created,
-				g.Value,
-			)
+// parseGoalDate will parse a goal date flag. If value is empty, def will be
+// returned.
+func parseGoalDate(value string, def connect.Date) connect.Date {
+	if value == "" {
+		return def
+	}
+
+	date, err := connect.ParseDate(value)
+	bail(err)
+
+	return date
+}
+
+func goalsAdd(_ *cobra.Command, args []string) {
+	if client.Profile == nil {
+		bail(connect.ErrNotAuthenticated)
+	}
+
+	typ, err := connect.ParseGoalType(args[0])
+	bail(err)
+
+	value, err := strconv.Atoi(args[1])
+	bail(err)
+
+	goal := connect.Goal{
+		ProfileID: client.Profile.ProfileID,
+		GoalType:  typ,
+		Start:     parseGoalDate(goalsStart, connect.Today()),
+		End:       parseGoalDate(goalsEnd, connect.Date{}),
+		Value:     value,
+		Created:   connect.Today(),
+	}
+
+	err = client.AddGoal("", goal)
+	bail(err)
+}
+
+func goalsUpdate(cmd *cobra.Command, args []string) {
+	goalID := idArg(args[0])
+
+	value, err := strconv.Atoi(args[1])
+	bail(err)
+
+	var goal *connect.Goal
+	for _, g := range allGoals("") {
+		if g.ID == goalID {
+			goal = &g
+			break
 		}
 	}
-	t.Output(os.Stdout)
+
+	if goal == nil {
+		bail(connect.ErrNotFound)
+	}
+
+	goal.Value = value
+
+	if cmd.Flags().Changed("start") {
+		goal.Start = parseGoalDate(goalsStart, goal.Start)
+	}
+
+	if cmd.Flags().Changed("end") {
+		goal.End = parseGoalDate(goalsEnd, connect.Date{})
+	}
+
+	err = client.UpdateGoal("", *goal)
+	bail(err)
 }
 
 func goalsDelete(_ *cobra.Command, args []string) {
-	goalID, err := strconv.Atoi(args[0])
+	goalID := idArg(args[0])
+
+	err := client.DeleteGoal("", int(goalID))
 	bail(err)
+}
+
+// parseWeekday will parse the English name of a weekday.
+func parseWeekday(name string) time.Weekday {
+	for d := time.Sunday; d <= time.Saturday; d++ {
+		if strings.EqualFold(d.String(), name) {
+			return d
+		}
+	}
+
+	bail(fmt.Errorf("unknown weekday '%s'", name))
+
+	return time.Sunday
+}
+
+// goalDayValues will convert a value per day starting at from to date
+// values.
+func goalDayValues(from time.Time, values []float64) []connect.DateValue {
+	ret := make([]connect.DateValue, len(values))
+	for i, v := range values {
+		ret[i] = connect.DateValue{
+			Date:  connect.NewDate(from.AddDate(0, 0, i)),
+			Value: v,
+		}
+	}
+
+	return ret
+}
+
+func goalsProgress(_ *cobra.Command, args []string) {
+	displayName := ""
+	if len(args) == 1 {
+		displayName = args[0]
+	}
+
+	if displayName == "" {
+		if client.Profile == nil {
+			bail(connect.ErrNotAuthenticated)
+		}
 
-	err = client.DeleteGoal("", goalID)
+		displayName = client.Profile.DisplayName
+	}
+
+	weekStart := parseWeekday(goalsWeekStart)
+
+	until := time.Now()
+	from := until.AddDate(0, 0, -goalsDays+1)
+
+	// Weekly goals need up to six days of history before the first day.
+	history := from.AddDate(0, 0, -6)
+
+	summaries, err := client.DailySummaries(displayName, history, until)
 	bail(err)
+
+	first := connect.NewDate(from)
+
+	t := NewTable()
+	t.AddHeader("ID", "Type", "Goal", "Met", "Streak", "Longest", "Days")
+	for _, g := range allGoals(displayName) {
+		values, ok := g.GoalType.GoalValues(summaries)
+
+		// Sleep and hydration are not part of the daily summaries, and
+		// must be retrieved day by day.
+		switch g.GoalType {
+		case connect.GoalTypeSleep:
+			nights, err := client.SleepRange(displayName, from, until)
+			bail(err)
+
+			values, ok = connect.SleepGoalValues(from, nights), true
+
+		case connect.GoalTypeHydration:
+			days, err := client.HydrationRange(from, until)
+			bail(err)
+
+			intake := make([]float64, len(days))
+			for i, d := range days {
+				intake[i] = d.Intake
+			}
+
+			values, ok = goalDayValues(from, intake), true
+		}
+
+		if !ok {
+			t.AddRow(g.ID, g.GoalType, g.Value, "n/a", "n/a", "n/a", "")
+			continue
+		}
+
+		// Only count the requested days, history before that is only
+		// used for the weekly sums.
+		if g.Start.Time().Before(first.Time()) {
+			g.Start = first
+		}
+
+		progress := connect.NewGoalProgress(g, values, weekStart)
+
+		met := make([]float64, len(progress.Days))
+		for i, d := range progress.Days {
+			if d.Met {
+				met[i] = 1.0
+			}
+		}
+
+		t.AddRow(
+			g.ID,
+			g.GoalType,
+			g.Value,
+			fmt.Sprintf("%d/%d", progress.Met, len(progress.Days)),
+			progress.Streak,
+			progress.Longest,
+			sparkline(met, 0.0, 1.0),
+		)
+	}
+	t.Output(os.Stdout)
 }
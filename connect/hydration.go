@@ -0,0 +1,135 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	connect "github.com/abrander/garmin-connect"
+)
+
+var (
+	hydrationDate string
+)
+
+func init() {
+	hydrationCmd := &cobra.Command{
+		Use:   "hydration [yyyy-mm-dd]",
+		Short: "Show hydration for a date, defaults to today",
+		Run:   hydrationShow,
+		Args:  cobra.RangeArgs(0, 1),
+	}
+	rootCmd.AddCommand(hydrationCmd)
+
+	hydrationLogCmd := &cobra.Command{
+		Use:   "log <amount>",
+		Short: "Log fluid intake like 500ml, 0.5l or 16oz",
+		Run:   hydrationLog,
+		Args:  cobra.ExactArgs(1),
+	}
+	hydrationLogCmd.Flags().StringVar(&hydrationDate, "date", "", "Date of the intake (yyyy-mm-dd), defaults to now")
+	hydrationCmd.AddCommand(hydrationLogCmd)
+
+	hydrationRangeCmd := &cobra.Command{
+		Use:   "range <yyyy-mm-dd> <yyyy-mm-dd>",
+		Short: "Show hydration for a date range",
+		Run:   hydrationRange,
+		Args:  cobra.ExactArgs(2),
+	}
+	hydrationCmd.AddCommand(hydrationRangeCmd)
+}
+
+// parseVolume will parse a volume with a unit and return it in ml. Numbers
+// without a unit are considered ml.
+func parseVolume(value string) (float64, error) {
+	units := []struct {
+		suffix string
+		ml     float64
+	}{
+		{"ml", 1.0},
+		{"cl", 10.0},
+		{"dl", 100.0},
+		{"l", 1000.0},
+		{"oz", 29.5735},
+		{"", 1.0},
+	}
+
+	value = strings.ToLower(strings.TrimSpace(value))
+
+	for _, u := range units {
+		if !strings.HasSuffix(value, u.suffix) {
+			continue
+		}
+
+		f, err := strconv.ParseFloat(strings.TrimSpace(strings.TrimSuffix(value, u.suffix)), 64)
+		if err != nil {
+			return 0.0, fmt.Errorf("unable to parse volume '%s'", value)
+		}
+
+		return f * u.ml, nil
+	}
+
+	return 0.0, fmt.Errorf("unable to parse volume '%s'", value)
+}
+
+func hydrationShow(_ *cobra.Command, args []string) {
+	date := time.Now()
+	if len(args) > 0 {
+		d, err := connect.ParseDate(args[0])
+		bail(err)
+
+		date = d.Time()
+	}
+
+	hydration, err := client.Hydration(date)
+	bail(err)
+
+	t := NewTabular()
+	t.AddValue("Date", hydration.Date)
+	t.AddValueUnit("Intake", hydration.Intake, "ml")
+	t.AddValueUnit("Goal", hydration.Goal, "ml")
+	t.AddValueUnit("Sweat Loss", hydration.SweatLoss, "ml")
+	t.AddValueUnit("Activity Intake", hydration.ActivityIntake, "ml")
+	t.AddValueUnit("Daily Average", hydration.DailyAverage, "ml")
+	t.Output(os.Stdout)
+}
+
+func hydrationLog(_ *cobra.Command, args []string) {
+	intake, err := parseVolume(args[0])
+	bail(err)
+
+	timestamp := time.Now()
+	if hydrationDate != "" {
+		date, err := connect.ParseDate(hydrationDate)
+		bail(err)
+
+		timestamp = time.Date(date.Year, date.Month, date.DayOfMonth, timestamp.Hour(), timestamp.Minute(), timestamp.Second(), 0, time.Local)
+	}
+
+	err = client.LogHydration(timestamp, intake)
+	bail(err)
+}
+
+func hydrationRange(_ *cobra.Command, args []string) {
+	from, to := dateRangeArgs(args)
+
+	days, err := client.HydrationRange(from, to)
+	bail(err)
+
+	t := NewTable()
+	t.AddHeader("Date", "Intake", "Goal", "Sweat Loss", "Goal Met")
+	for _, h := range days {
+		t.AddRow(
+			h.Date,
+			nzf(h.Intake),
+			nzf(h.Goal),
+			nzf(h.SweatLoss),
+			h.Goal > 0.0 && h.Intake >= h.Goal,
+		)
+	}
+	t.Output(os.Stdout)
+}
@@ -0,0 +1,60 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	connect "github.com/abrander/garmin-connect"
+)
+
+func init() {
+	intradayCmd := &cobra.Command{
+		Use:   "intraday",
+		Short: "Show intraday wellness data",
+	}
+	rootCmd.AddCommand(intradayCmd)
+
+	series := []struct {
+		use   string
+		short string
+		unit  string
+		fn    func(from time.Time, to time.Time) (connect.Series, error)
+	}{
+		{"heartrate", "Show heart rate", "bpm", client.IntradayHeartRateRange},
+		{"steps", "Show steps in 15 minute buckets", "steps", client.IntradayStepsRange},
+		{"respiration", "Show respiration rate", "brpm", client.IntradayRespirationRange},
+		{"spo2", "Show hourly pulse ox averages", "%", client.IntradayPulseOxRange},
+		{"bodybattery", "Show body battery level", "", client.IntradayBodyBatteryRange},
+	}
+
+	for _, s := range series {
+		s := s
+
+		cmd := &cobra.Command{
+			Use:   s.use + " <yyyy-mm-dd> [yyyy-mm-dd]",
+			Short: s.short,
+			Run: func(_ *cobra.Command, args []string) {
+				intradaySeries(args, s.unit, s.fn)
+			},
+			Args: cobra.RangeArgs(1, 2),
+		}
+		intradayCmd.AddCommand(cmd)
+	}
+}
+
+func intradaySeries(args []string, unit string, fn func(from time.Time, to time.Time) (connect.Series, error)) {
+	from, to := dateRangeArgs(args)
+
+	series, err := fn(from, to)
+	bail(err)
+
+	t := NewTable()
+	t.AddHeader("Time", "Value")
+	for _, sample := range series {
+		t.AddRow(sample.Timestamp, fmt.Sprintf("%.0f %s", sample.Value, unit))
+	}
+	t.Output(os.Stdout)
+}
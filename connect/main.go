@@ -36,8 +36,16 @@ var (
 
 	verbose  bool
 	dumpFile string
+
+	// exitCode is the exit status used after a command completed without
+	// errors. Commands can set this to signal a condition to scripts.
+	exitCode int
 )
 
+// exitAlert is the exit status used when a command raised an alert. It
+// differs from the status used by bail() to tell alerts from errors.
+const exitAlert = 2
+
 func init() {
 	rootCmd.PersistentFlags().BoolVarP(&verbose, "verbose", "v", false, "verbose debug output")
 	rootCmd.PersistentFlags().StringVarP(&dumpFile, "dump", "d", "", "File to dump requests and responses to")
@@ -67,6 +75,9 @@ func bail(err error) {
 
 func main() {
 	bail(rootCmd.Execute())
+
+	// The state is stored at this point.
+	os.Exit(exitCode)
 }
 
 func authenticate(_ *cobra.Command, args []string) {
@@ -0,0 +1,187 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	connect "github.com/abrander/garmin-connect"
+)
+
+func init() {
+	planCmd := &cobra.Command{
+		Use: "plan",
+	}
+	rootCmd.AddCommand(planCmd)
+
+	planListCmd := &cobra.Command{
+		Use:   "list",
+		Short: "List enrolled training plans",
+		Run:   planList,
+		Args:  cobra.NoArgs,
+	}
+	planCmd.AddCommand(planListCmd)
+
+	planAvailableCmd := &cobra.Command{
+		Use:   "available",
+		Short: "List training plans available for enrollment",
+		Run:   planAvailable,
+		Args:  cobra.NoArgs,
+	}
+	planCmd.AddCommand(planAvailableCmd)
+
+	planViewCmd := &cobra.Command{
+		Use:   "view <plan id>",
+		Short: "Show the schedule of a training plan",
+		Run:   planView,
+		Args:  cobra.ExactArgs(1),
+	}
+	planCmd.AddCommand(planViewCmd)
+
+	planStatusCmd := &cobra.Command{
+		Use:   "status [plan id]",
+		Short: "Show this week of a training plan and what was done",
+		Run:   planStatus,
+		Args:  cobra.RangeArgs(0, 1),
+	}
+	planCmd.AddCommand(planStatusCmd)
+}
+
+func outputPlans(plans []connect.TrainingPlan) {
+	t := NewTable()
+	t.AddHeader("ID", "Name", "Type", "Level", "Weeks", "Start", "End")
+	for _, p := range plans {
+		t.AddRow(p.ID, p.Name, p.Type.Key, p.Level.Key, p.Weeks, p.Start, p.End)
+	}
+	t.Output(os.Stdout)
+}
+
+func planList(_ *cobra.Command, _ []string) {
+	plans, err := client.TrainingPlans()
+	bail(err)
+
+	outputPlans(plans)
+}
+
+func planAvailable(_ *cobra.Command, _ []string) {
+	plans, err := client.AvailableTrainingPlans()
+	bail(err)
+
+	outputPlans(plans)
+}
+
+// planArg will retrieve the plan given in args, or the first enrolled
+// plan.
+func planArg(args []string) *connect.TrainingPlan {
+	var id int64
+
+	if len(args) > 0 {
+		id = idArg(args[0])
+	} else {
+		plans, err := client.TrainingPlans()
+		bail(err)
+
+		if len(plans) == 0 {
+			fmt.Printf("Not enrolled in any training plan\n")
+			os.Exit(1)
+		}
+
+		id = plans[0].ID
+	}
+
+	plan, err := client.TrainingPlan(id)
+	bail(err)
+
+	return plan
+}
+
+func planView(_ *cobra.Command, args []string) {
+	plan := planArg(args)
+
+	t := NewTable()
+	t.AddHeader("Date", "Week", "Workout", "Sport", "Duration")
+	for _, task := range plan.Tasks {
+		name := task.Workout.Name
+		if task.Workout.RestDay {
+			name = "rest"
+		}
+
+		t.AddRow(
+			task.Date,
+			task.Week,
+			name,
+			task.Workout.SportType.Key,
+			hoursAndMinutes(time.Duration(task.Workout.EstimatedDuration)*time.Second),
+		)
+	}
+	t.Output(os.Stdout)
+}
+
+func planStatus(_ *cobra.Command, args []string) {
+	plan := planArg(args)
+
+	now := time.Now()
+	monday := now.AddDate(0, 0, -(int(now.Weekday())+6)%7)
+	sunday := monday.AddDate(0, 0, 6)
+
+	// Not all plans carry a start date, use the first task then.
+	start := plan.Start
+	for _, task := range plan.Tasks {
+		if task.Date == (connect.Date{}) {
+			continue
+		}
+
+		if start == (connect.Date{}) || task.Date.Time().Before(start.Time()) {
+			start = task.Date
+		}
+	}
+
+	from := monday
+	if start != (connect.Date{}) {
+		from = start.Time()
+	}
+
+	calendar, err := client.CalendarItems(from, sunday)
+	bail(err)
+
+	types, err := client.ActivityTypes()
+	bail(err)
+
+	adherence := connect.PlanAdherence(plan.Tasks, calendar, types, now)
+
+	t := NewTable()
+	t.AddHeader("Date", "Workout", "Duration", "Done", "Activity")
+	for _, day := range adherence.Days {
+		date := day.Task.Date.Time()
+		if date.Before(connect.NewDate(monday).Time()) || date.After(connect.NewDate(sunday).Time()) {
+			continue
+		}
+
+		activity := ""
+		if len(day.Activities) > 0 {
+			activity = day.Activities[0].Title
+		}
+
+		t.AddRow(
+			day.Task.Date,
+			day.Task.Workout.Name,
+			hoursAndMinutes(time.Duration(day.Task.Workout.EstimatedDuration)*time.Second),
+			day.Completed,
+			activity,
+		)
+	}
+	fmt.Printf("%s\n\n", plan.Name)
+	t.Output(os.Stdout)
+	fmt.Printf("\n")
+
+	s := NewTabular()
+	s.AddValue("Scheduled", adherence.Scheduled)
+	s.AddValue("Completed", adherence.Completed)
+	s.AddValue("Missed", adherence.Missed)
+	if adherence.Scheduled > 0 {
+		s.AddValueUnit("Adherence", 100.0*float64(adherence.Completed)/float64(adherence.Scheduled), "%")
+	}
+	s.Output(os.Stdout)
+}
@@ -0,0 +1,193 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	connect "github.com/abrander/garmin-connect"
+)
+
+var (
+	segmentsNear   string
+	segmentsRadius float64
+	segmentsBounds string
+	segmentsLimit  int
+)
+
+func init() {
+	segmentsCmd := &cobra.Command{
+		Use: "segments",
+	}
+	rootCmd.AddCommand(segmentsCmd)
+
+	segmentsSearchCmd := &cobra.Command{
+		Use:   "search",
+		Short: "Search for segments near a coordinate or inside a bounding box",
+		Run:   segmentsSearch,
+		Args:  cobra.NoArgs,
+	}
+	segmentsSearchCmd.Flags().StringVar(&segmentsNear, "near", "", "Coordinate as lat,lon")
+	segmentsSearchCmd.Flags().Float64Var(&segmentsRadius, "radius", 2000.0, "Search radius in meters when using --near")
+	segmentsSearchCmd.Flags().StringVar(&segmentsBounds, "bbox", "", "Bounding box as south,west,north,east")
+	segmentsCmd.AddCommand(segmentsSearchCmd)
+
+	segmentsViewCmd := &cobra.Command{
+		Use:   "view <segment id>",
+		Short: "Show details about a segment",
+		Run:   segmentsView,
+		Args:  cobra.ExactArgs(1),
+	}
+	segmentsCmd.AddCommand(segmentsViewCmd)
+
+	segmentsLeaderboardCmd := &cobra.Command{
+		Use:   "leaderboard <segment id>",
+		Short: "Show the leaderboard of a segment",
+		Run:   segmentsLeaderboard,
+		Args:  cobra.ExactArgs(1),
+	}
+	segmentsLeaderboardCmd.Flags().IntVar(&segmentsLimit, "limit", 50, "Number of efforts to show")
+	segmentsCmd.AddCommand(segmentsLeaderboardCmd)
+
+	segmentsEffortsCmd := &cobra.Command{
+		Use:   "efforts <segment id>",
+		Short: "List your efforts on a segment",
+		Run:   segmentsEfforts,
+		Args:  cobra.ExactArgs(1),
+	}
+	segmentsCmd.AddCommand(segmentsEffortsCmd)
+
+	segmentsStarCmd := &cobra.Command{
+		Use:   "star <segment id>",
+		Short: "Star a segment",
+		Run:   segmentsStar,
+		Args:  cobra.ExactArgs(1),
+	}
+	segmentsCmd.AddCommand(segmentsStarCmd)
+
+	segmentsUnstarCmd := &cobra.Command{
+		Use:   "unstar <segment id>",
+		Short: "Remove star from a segment",
+		Run:   segmentsUnstar,
+		Args:  cobra.ExactArgs(1),
+	}
+	segmentsCmd.AddCommand(segmentsUnstarCmd)
+}
+
+// parseFloats will parse a comma separated list of exactly n floats.
+func parseFloats(value string, n int) []float64 {
+	parts := strings.Split(value, ",")
+	if len(parts) != n {
+		bail(fmt.Errorf("expected %d comma separated numbers, got '%s'", n, value))
+	}
+
+	floats := make([]float64, n)
+	for i, part := range parts {
+		var err error
+		floats[i], err = strconv.ParseFloat(strings.TrimSpace(part), 64)
+		bail(err)
+	}
+
+	return floats
+}
+
+func segmentsSearch(_ *cobra.Command, _ []string) {
+	var segments []connect.Segment
+	var err error
+
+	switch {
+	case segmentsBounds != "":
+		b := parseFloats(segmentsBounds, 4)
+		segments, err = client.SegmentsInBounds(connect.BoundingBox{South: b[0], West: b[1], North: b[2], East: b[3]})
+	case segmentsNear != "":
+		c := parseFloats(segmentsNear, 2)
+		segments, err = client.SegmentsNear(c[0], c[1], segmentsRadius)
+	default:
+		err = fmt.Errorf("either --near or --bbox is required")
+	}
+	bail(err)
+
+	t := NewTable()
+	t.AddHeader("ID", "Name", "Type", "Distance", "Elevation Gain", "Grade", "Starred")
+	for _, s := range segments {
+		t.AddRow(
+			s.ID,
+			s.Name,
+			s.ActivityType.TypeKey,
+			s.Distance/1000.0,
+			s.ElevationGain,
+			s.AverageGrade,
+			s.Starred,
+		)
+	}
+	t.Output(os.Stdout)
+}
+
+func segmentsView(_ *cobra.Command, args []string) {
+	segment, err := client.Segment(idArg(args[0]))
+	bail(err)
+
+	t := NewTabular()
+	t.AddValue("ID", segment.ID)
+	t.AddValue("Name", segment.Name)
+	t.AddValue("Type", segment.ActivityType.TypeKey)
+	t.AddValueUnit("Distance", segment.Distance/1000.0, "km")
+	t.AddValueUnit("Elevation Gain", segment.ElevationGain, "m")
+	t.AddValueUnit("Grade", segment.AverageGrade, "%")
+	t.AddValue("Starred", segment.Starred)
+	t.AddValue("Points", len(segment.Points))
+	if len(segment.Points) > 0 {
+		first, last := segment.Points[0], segment.Points[len(segment.Points)-1]
+		t.AddValue("Start", fmt.Sprintf("%.5f,%.5f", first.Lat, first.Lon))
+		t.AddValue("End", fmt.Sprintf("%.5f,%.5f", last.Lat, last.Lon))
+	}
+	t.Output(os.Stdout)
+}
+
+func outputSegmentEfforts(efforts []connect.SegmentEffort) {
+	t := NewTable()
+	t.AddHeader("Rank", "Name", "Time", "Date", "Avg HR", "Activity ID")
+	for _, e := range efforts {
+		name := e.FullName
+		if name == "" {
+			name = e.DisplayName
+		}
+
+		t.AddRow(
+			e.Rank,
+			name,
+			e.ElapsedTime,
+			formatDate(e.Start.Time),
+			nzf(e.AverageHeartRate),
+			e.ActivityID,
+		)
+	}
+	t.Output(os.Stdout)
+}
+
+func segmentsLeaderboard(_ *cobra.Command, args []string) {
+	efforts, err := client.SegmentLeaderboard(idArg(args[0]), 0, segmentsLimit)
+	bail(err)
+
+	outputSegmentEfforts(efforts)
+}
+
+func segmentsEfforts(_ *cobra.Command, args []string) {
+	efforts, err := client.SegmentEfforts(idArg(args[0]))
+	bail(err)
+
+	outputSegmentEfforts(efforts)
+}
+
+func segmentsStar(_ *cobra.Command, args []string) {
+	err := client.StarSegment(idArg(args[0]))
+	bail(err)
+}
+
+func segmentsUnstar(_ *cobra.Command, args []string) {
+	err := client.UnstarSegment(idArg(args[0]))
+	bail(err)
+}
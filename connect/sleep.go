@@ -3,6 +3,7 @@ package main
 import (
 	"fmt"
 	"os"
+	"time"
 
 	connect "github.com/abrander/garmin-connect"
 	"github.com/spf13/cobra"
@@ -21,6 +22,21 @@ func init() {
 		Args:  cobra.RangeArgs(1, 2),
 	}
 	sleepCmd.AddCommand(sleepSummaryCmd)
+
+	sleepRangeCmd := &cobra.Command{
+		Use:   "range <yyyy-mm-dd> <yyyy-mm-dd> [displayName]",
+		Short: "Show sleep for a date range",
+		Run:   sleepRange,
+		Args:  cobra.RangeArgs(2, 3),
+	}
+	sleepCmd.AddCommand(sleepRangeCmd)
+}
+
+// clock formats a duration since midnight as a time of day.
+func clock(d time.Duration) string {
+	d = d.Round(time.Minute) % (24 * time.Hour)
+
+	return fmt.Sprintf("%02d:%02d", d/time.Hour, (d%time.Hour)/time.Minute)
 }
 
 func sleepSummary(_ *cobra.Command, args []string) {
@@ -49,6 +65,13 @@ func sleepSummary(_ *cobra.Command, args []string) {
 	t.AddValue("Confirmed", summary.Confirmed)
 	t.AddValue("Confirmation Type", summary.Confirmation)
 	t.AddValue("REM Data", summary.REMData)
+	t.AddValue("Score", summary.Scores.Overall.Value)
+	t.AddValue("Quality", summary.Scores.Overall.Qualifier)
+	t.AddValue("Sleep Need", hoursAndMinutes(summary.Need.Actual))
+	t.AddValue("Feedback", summary.ScoreFeedback)
+	t.AddValueUnit("Respiration", nzf(summary.AverageRespiration), "brpm")
+	t.AddValueUnit("SpO2", nzf(summary.AverageSpO2), "%")
+	t.AddValueUnit("HRV", nzf(summary.AverageHRV), "ms")
 	t.Output(os.Stdout)
 
 	fmt.Fprintf(os.Stdout, "\n")
@@ -60,3 +83,53 @@ func sleepSummary(_ *cobra.Command, args []string) {
 	}
 	t2.Output(os.Stdout)
 }
+
+func sleepRange(_ *cobra.Command, args []string) {
+	from, to := dateRangeArgs(args[:2])
+
+	displayName := ""
+
+	if len(args) > 2 {
+		displayName = args[2]
+	}
+
+	summaries, err := client.SleepRange(displayName, from, to)
+	bail(err)
+
+	t := NewTable()
+	t.AddHeader("Start", "End", "Sleep", "Deep", "Light", "REM", "Awake", "Score", "Respiration", "SpO2", "HRV")
+	for _, s := range summaries {
+		if s.Sleep <= 0 {
+			continue
+		}
+
+		t.AddRow(
+			s.StartLocal,
+			s.EndLocal,
+			hoursAndMinutes(s.Sleep),
+			hoursAndMinutes(s.Deep),
+			hoursAndMinutes(s.Light),
+			hoursAndMinutes(s.REM),
+			hoursAndMinutes(s.Awake),
+			s.Scores.Overall.Value,
+			nzf(s.AverageRespiration),
+			nzf(s.AverageSpO2),
+			nzf(s.AverageHRV),
+		)
+	}
+	t.Output(os.Stdout)
+
+	aggregate := connect.AggregateSleep(summaries)
+
+	t2 := NewTabular()
+	t2.AddValueUnit("Nights", aggregate.Nights, "")
+	t2.AddValue("Average Sleep", hoursAndMinutes(aggregate.AverageSleep))
+	t2.AddValueUnit("Average Score", nzf(aggregate.AverageScore), "")
+	t2.AddValueUnit("Deep", aggregate.DeepPercentage, "%")
+	t2.AddValueUnit("Light", aggregate.LightPercentage, "%")
+	t2.AddValueUnit("REM", aggregate.REMPercentage, "%")
+	t2.AddValueUnit("Bedtime", clock(aggregate.AverageBedtime), "± "+hoursAndMinutes(aggregate.BedtimeDeviation))
+	t2.AddValueUnit("Wake Time", clock(aggregate.AverageWakeTime), "± "+hoursAndMinutes(aggregate.WakeTimeDeviation))
+	fmt.Fprintf(os.Stdout, "\n")
+	t2.Output(os.Stdout)
+}
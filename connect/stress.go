@@ -0,0 +1,142 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	connect "github.com/abrander/garmin-connect"
+)
+
+func init() {
+	stressCmd := &cobra.Command{
+		Use:   "stress <yyyy-mm-dd>",
+		Short: "Show stress for a date",
+		Run:   stress,
+		Args:  cobra.ExactArgs(1),
+	}
+	rootCmd.AddCommand(stressCmd)
+
+	stressRangeCmd := &cobra.Command{
+		Use:   "range <yyyy-mm-dd> <yyyy-mm-dd>",
+		Short: "Show hourly stress for a date range",
+		Run:   stressRange,
+		Args:  cobra.ExactArgs(2),
+	}
+	stressCmd.AddCommand(stressRangeCmd)
+}
+
+// stressHeatmapCell returns a two character cell for the stress points of
+// a single hour.
+func stressHeatmapCell(points []connect.StressPoint) string {
+	sum, count, activity := 0, 0, false
+
+	for _, p := range points {
+		switch p.Category() {
+		case connect.StressActivity:
+			activity = true
+		case connect.StressUnmeasured:
+		default:
+			sum += p.Value
+			count++
+		}
+	}
+
+	if count == 0 {
+		if activity {
+			return "~~"
+		}
+
+		return "  "
+	}
+
+	switch connect.StressCategoryOf(sum / count) {
+	case connect.StressRest:
+		return "░░"
+	case connect.StressLow:
+		return "▒▒"
+	case connect.StressMedium:
+		return "▓▓"
+	default:
+		return "██"
+	}
+}
+
+// stressHeatmap will output a line per day with a cell for every hour.
+func stressHeatmap(w io.Writer, days []connect.DailyStress) {
+	fmt.Fprintf(w, "           ")
+	for hour := 0; hour < 24; hour += 3 {
+		fmt.Fprintf(w, "%-6d", hour)
+	}
+	fmt.Fprintf(w, "\n")
+
+	for _, day := range days {
+		hours := make([][]connect.StressPoint, 24)
+		for _, p := range day.Values {
+			hour := p.Timestamp.Hour()
+			hours[hour] = append(hours[hour], p)
+		}
+
+		cells := make([]string, 24)
+		for hour, points := range hours {
+			cells[hour] = stressHeatmapCell(points)
+		}
+
+		fmt.Fprintf(w, "%s %s\n", day.CalendarDate, strings.Join(cells, ""))
+	}
+
+	fmt.Fprintf(w, "\n░░ rest  ▒▒ low  ▓▓ medium  ██ high  ~~ activity\n")
+}
+
+func stress(_ *cobra.Command, args []string) {
+	date, err := connect.ParseDate(args[0])
+	bail(err)
+
+	day, err := client.DailyStress(date.Time())
+	bail(err)
+
+	t := NewTabular()
+	t.AddValue("Date", day.CalendarDate)
+	t.AddValue("Average", day.Average)
+	t.AddValue("Max", day.Max)
+
+	durations := day.Durations()
+	for _, category := range connect.StressCategories {
+		t.AddValue(category.String(), hoursAndMinutes(durations[category]))
+	}
+	t.Output(os.Stdout)
+
+	fmt.Fprintf(os.Stdout, "\n")
+	stressHeatmap(os.Stdout, []connect.DailyStress{*day})
+}
+
+func stressRange(_ *cobra.Command, args []string) {
+	from, to := dateRangeArgs(args)
+
+	days, err := client.DailyStressRange(from, to)
+	bail(err)
+
+	t := NewTable()
+	t.AddHeader("Date", "Average", "Max", "Rest", "Low", "Medium", "High", "Activity")
+	for _, day := range days {
+		durations := day.Durations()
+
+		t.AddRow(
+			day.CalendarDate,
+			day.Average,
+			day.Max,
+			hoursAndMinutes(durations[connect.StressRest]),
+			hoursAndMinutes(durations[connect.StressLow]),
+			hoursAndMinutes(durations[connect.StressMedium]),
+			hoursAndMinutes(durations[connect.StressHigh]),
+			hoursAndMinutes(durations[connect.StressActivity]),
+		)
+	}
+	t.Output(os.Stdout)
+
+	fmt.Fprintf(os.Stdout, "\n")
+	stressHeatmap(os.Stdout, days)
+}
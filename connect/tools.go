@@ -2,6 +2,7 @@ package main
 
 import (
 	"fmt"
+	"math"
 	"strconv"
 	"time"
 )
@@ -68,3 +69,42 @@ func hoursAndMinutes(dur time.Duration) string {
 
 	return fmt.Sprintf("%dh%dm", h, m)
 }
+
+// sparkline renders values as a line of block characters scaled between
+// min and max. NaN values are rendered as a space.
+func sparkline(values []float64, min float64, max float64) string {
+	blocks := []rune("▁▂▃▄▅▆▇█")
+
+	line := make([]rune, len(values))
+	for i, v := range values {
+		if math.IsNaN(v) {
+			line[i] = ' '
+			continue
+		}
+
+		level := 0
+		if max > min {
+			level = int((v - min) / (max - min) * float64(len(blocks)-1))
+		}
+
+		if level < 0 {
+			level = 0
+		}
+
+		if level >= len(blocks) {
+			level = len(blocks) - 1
+		}
+
+		line[i] = blocks[level]
+	}
+
+	return string(line)
+}
+
+// idArg will parse a numeric ID given as argument.
+func idArg(arg string) int64 {
+	id, err := strconv.ParseInt(arg, 10, 64)
+	bail(err)
+
+	return id
+}
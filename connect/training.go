@@ -0,0 +1,350 @@
+package main
+
+import (
+	"encoding/csv"
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	connect "github.com/abrander/garmin-connect"
+)
+
+var (
+	trainingSince     string
+	trainingUntil     string
+	trainingCSV       bool
+	trainingRestingHR int
+	trainingMaxHR     int
+	trainingFemale    bool
+)
+
+func init() {
+	trainingCmd := &cobra.Command{
+		Use: "training",
+	}
+	rootCmd.AddCommand(trainingCmd)
+
+	trainingLoadCmd := &cobra.Command{
+		Use:   "load",
+		Short: "Show training load, fitness, fatigue and form",
+		Run:   trainingLoad,
+		Args:  cobra.NoArgs,
+	}
+	trainingLoadCmd.Flags().StringVar(&trainingSince, "since", "", "First date to show (yyyy-mm-dd), defaults to 90 days ago")
+	trainingLoadCmd.Flags().StringVar(&trainingUntil, "until", "", "Last date to show (yyyy-mm-dd), defaults to today")
+	trainingLoadCmd.Flags().BoolVar(&trainingCSV, "csv", false, "Output as CSV")
+	trainingLoadCmd.Flags().IntVar(&trainingRestingHR, "resting-hr", 0, "Resting heart rate, use heart-rate streams when set together with --max-hr")
+	trainingLoadCmd.Flags().IntVar(&trainingMaxHR, "max-hr", 0, "Maximum heart rate, use heart-rate streams when set together with --resting-hr")
+	trainingLoadCmd.Flags().BoolVar(&trainingFemale, "female", false, "Use female weighting for heart-rate streams")
+	trainingCmd.AddCommand(trainingLoadCmd)
+
+	trainingStatusCmd := &cobra.Command{
+		Use:   "status <yyyy-mm-dd> [yyyy-mm-dd]",
+		Short: "Show training status for a date or a date range",
+		Run:   trainingStatus,
+		Args:  cobra.RangeArgs(1, 2),
+	}
+	trainingCmd.AddCommand(trainingStatusCmd)
+
+	trainingReadinessCmd := &cobra.Command{
+		Use:   "readiness <yyyy-mm-dd> [yyyy-mm-dd]",
+		Short: "Show training readiness for a date or a date range",
+		Run:   trainingReadiness,
+		Args:  cobra.RangeArgs(1, 2),
+	}
+	trainingCmd.AddCommand(trainingReadinessCmd)
+
+	trainingVO2MaxCmd := &cobra.Command{
+		Use:   "vo2max <yyyy-mm-dd> [yyyy-mm-dd]",
+		Short: "Show VO2max history for running and cycling",
+		Run:   trainingVO2Max,
+		Args:  cobra.RangeArgs(1, 2),
+	}
+	trainingCmd.AddCommand(trainingVO2MaxCmd)
+
+	trainingRaceCmd := &cobra.Command{
+		Use:   "race [yyyy-mm-dd yyyy-mm-dd]",
+		Short: "Show latest race predictions or predictions for a date range",
+		Run:   trainingRace,
+		Args:  noneOrTwoArgs,
+	}
+	trainingCmd.AddCommand(trainingRaceCmd)
+
+	trainingFitnessAgeCmd := &cobra.Command{
+		Use:   "fitnessage <yyyy-mm-dd> [yyyy-mm-dd]",
+		Short: "Show fitness age for a date or a date range",
+		Run:   trainingFitnessAge,
+		Args:  cobra.RangeArgs(1, 2),
+	}
+	trainingCmd.AddCommand(trainingFitnessAgeCmd)
+
+	trainingLactateCmd := &cobra.Command{
+		Use:   "lactate [yyyy-mm-dd yyyy-mm-dd]",
+		Short: "Show latest lactate threshold or thresholds detected in a date range",
+		Run:   trainingLactate,
+		Args:  noneOrTwoArgs,
+	}
+	trainingCmd.AddCommand(trainingLactateCmd)
+
+	trainingHRVCmd := &cobra.Command{
+		Use:   "hrv <yyyy-mm-dd> [yyyy-mm-dd]",
+		Short: "Show HRV status for a night or a date range",
+		Run:   trainingHRV,
+		Args:  cobra.RangeArgs(1, 2),
+	}
+	trainingCmd.AddCommand(trainingHRVCmd)
+}
+
+// noneOrTwoArgs is a cobra.PositionalArgs accepting either zero or two
+// arguments.
+func noneOrTwoArgs(_ *cobra.Command, args []string) error {
+	if len(args) != 0 && len(args) != 2 {
+		return fmt.Errorf("accepts 0 or 2 arg(s), received %d", len(args))
+	}
+
+	return nil
+}
+
+// dateRangeArgs will parse one or two dates from args. If only one date is
+// given, from and to will be equal.
+func dateRangeArgs(args []string) (time.Time, time.Time) {
+	from, err := connect.ParseDate(args[0])
+	bail(err)
+
+	to := from
+	if len(args) > 1 {
+		to, err = connect.ParseDate(args[1])
+		bail(err)
+	}
+
+	return from.Time(), to.Time()
+}
+
+// parseDateFlag will parse a date flag. If value is empty, def will be
+// returned.
+func parseDateFlag(value string, def time.Time) time.Time {
+	if value == "" {
+		return def
+	}
+
+	date, err := connect.ParseDate(value)
+	bail(err)
+
+	return date.Time()
+}
+
+func trainingLoad(_ *cobra.Command, _ []string) {
+	until := parseDateFlag(trainingUntil, time.Now())
+	since := parseDateFlag(trainingSince, until.AddDate(0, 0, -90))
+
+	// Include enough history to build up some fitness before since.
+	activities, err := client.ActivitiesRange(since.AddDate(0, 0, -connect.FitnessTimeConstant), until)
+	bail(err)
+
+	loads := make([]connect.DateValue, 0, len(activities))
+	for _, a := range activities {
+		trimp := -1.0
+
+		if trainingRestingHR > 0 && trainingMaxHR > 0 {
+			points, err := client.ActivityHeartRate(a.ID)
+			if err == nil {
+				trimp = connect.BanisterTRIMP(points, trainingRestingHR, trainingMaxHR, trainingFemale)
+			}
+		}
+
+		if trimp < 0.0 {
+			// Activities without heart rate data, like manual entries,
+			// don't add to the load.
+			trimp = 0.0
+
+			zones, err := client.ActivityHrZones(a.ID)
+			if err == nil {
+				trimp = connect.ZoneTRIMP(zones)
+			}
+		}
+
+		loads = append(loads, connect.DateValue{Date: connect.NewDate(a.StartLocal.Time), Value: trimp})
+	}
+
+	series := connect.TrainingLoads(loads, since, until)
+
+	if trainingCSV {
+		w := csv.NewWriter(os.Stdout)
+		bail(w.Write([]string{"date", "load", "fitness", "fatigue", "form", "acwr"}))
+		for _, l := range series {
+			bail(w.Write([]string{
+				l.Date.String(),
+				strconv.FormatFloat(l.Load, 'f', 1, 64),
+				strconv.FormatFloat(l.Fitness, 'f', 1, 64),
+				strconv.FormatFloat(l.Fatigue, 'f', 1, 64),
+				strconv.FormatFloat(l.Form, 'f', 1, 64),
+				strconv.FormatFloat(l.WorkloadRatio, 'f', 2, 64),
+			}))
+		}
+		w.Flush()
+		bail(w.Error())
+
+		return
+	}
+
+	t := NewTable()
+	t.AddHeader("Date", "Load", "Fitness", "Fatigue", "Form", "ACWR")
+	for _, l := range series {
+		t.AddRow(
+			l.Date,
+			nzf(l.Load),
+			l.Fitness,
+			l.Fatigue,
+			l.Form,
+			fmt.Sprintf("%.2f", l.WorkloadRatio),
+		)
+	}
+	t.Output(os.Stdout)
+}
+
+func trainingStatus(_ *cobra.Command, args []string) {
+	from, to := dateRangeArgs(args)
+
+	statuses, err := client.TrainingStatusRange(from, to)
+	bail(err)
+
+	t := NewTable()
+	t.AddHeader("Date", "Device ID", "Status", "Weekly Load", "Optimal Load", "Acute", "Chronic", "ACWR")
+	for _, s := range statuses {
+		t.AddRow(
+			s.Date,
+			s.DeviceID,
+			s.Status,
+			s.WeeklyLoad,
+			fmt.Sprintf("%.0f-%.0f", s.LoadTunnelMin, s.LoadTunnelMax),
+			s.AcuteLoad.Acute,
+			s.AcuteLoad.Chronic,
+			s.AcuteLoad.Ratio,
+		)
+	}
+	t.Output(os.Stdout)
+}
+
+func trainingReadiness(_ *cobra.Command, args []string) {
+	from, to := dateRangeArgs(args)
+
+	readiness, err := client.TrainingReadinessRange(from, to)
+	bail(err)
+
+	t := NewTable()
+	t.AddHeader("Time", "Score", "Level", "Sleep", "Recovery", "Acute Load", "HRV", "Stress History", "Sleep History", "Feedback")
+	for _, r := range readiness {
+		t.AddRow(
+			r.TimestampLocal,
+			r.Score,
+			r.Level,
+			fmt.Sprintf("%d%%", r.SleepScoreFactor),
+			fmt.Sprintf("%d%%", r.RecoveryTimeFactor),
+			fmt.Sprintf("%d%%", r.AcuteLoadFactor),
+			fmt.Sprintf("%d%%", r.HRVFactor),
+			fmt.Sprintf("%d%%", r.StressHistoryFactor),
+			fmt.Sprintf("%d%%", r.SleepHistoryFactor),
+			r.FeedbackShort,
+		)
+	}
+	t.Output(os.Stdout)
+}
+
+func trainingVO2Max(_ *cobra.Command, args []string) {
+	from, to := dateRangeArgs(args)
+
+	estimates, err := client.VO2MaxRange(from, to)
+	bail(err)
+
+	t := NewTable()
+	t.AddHeader("Date", "Sport", "VO2max", "Precise")
+	for _, e := range estimates {
+		t.AddRow(e.Date, e.Sport, e.Value, e.PreciseValue)
+	}
+	t.Output(os.Stdout)
+}
+
+func trainingRace(_ *cobra.Command, args []string) {
+	var predictions []connect.RacePredictions
+
+	if len(args) == 0 {
+		latest, err := client.RacePredictions("")
+		bail(err)
+
+		predictions = append(predictions, *latest)
+	} else {
+		from, to := dateRangeArgs(args)
+
+		var err error
+		predictions, err = client.RacePredictionsRange("", from, to)
+		bail(err)
+	}
+
+	t := NewTable()
+	t.AddHeader("Date", "5K", "10K", "Half Marathon", "Marathon")
+	for _, p := range predictions {
+		t.AddRow(p.Date, p.FiveK, p.TenK, p.HalfMarathon, p.Marathon)
+	}
+	t.Output(os.Stdout)
+}
+
+func trainingFitnessAge(_ *cobra.Command, args []string) {
+	from, to := dateRangeArgs(args)
+
+	ages, err := client.FitnessAgeRange(from, to)
+	bail(err)
+
+	t := NewTable()
+	t.AddHeader("Date", "Age", "Fitness Age", "Achievable")
+	for _, a := range ages {
+		t.AddRow(a.Date, a.ChronologicalAge, nzf(a.FitnessAge), nzf(a.AchievableFitnessAge))
+	}
+	t.Output(os.Stdout)
+}
+
+func trainingLactate(_ *cobra.Command, args []string) {
+	var thresholds []connect.LactateThreshold
+
+	if len(args) == 0 {
+		latest, err := client.LatestLactateThreshold()
+		bail(err)
+
+		thresholds = append(thresholds, *latest)
+	} else {
+		from, to := dateRangeArgs(args)
+
+		var err error
+		thresholds, err = client.LactateThresholdRange(from, to)
+		bail(err)
+	}
+
+	t := NewTable()
+	t.AddHeader("Date", "Heart Rate", "Speed (m/s)")
+	for _, l := range thresholds {
+		t.AddRow(l.Date, l.HeartRate, nzf(l.Speed))
+	}
+	t.Output(os.Stdout)
+}
+
+func trainingHRV(_ *cobra.Command, args []string) {
+	from, to := dateRangeArgs(args)
+
+	statuses, err := client.HRVStatusRange(from, to)
+	bail(err)
+
+	t := NewTable()
+	t.AddHeader("Date", "Last Night", "Weekly Average", "Baseline", "Status")
+	for _, s := range statuses {
+		t.AddRow(
+			s.Date,
+			s.LastNight,
+			s.WeeklyAverage,
+			fmt.Sprintf("%d-%d", s.Baseline.BalancedLow, s.Baseline.BalancedUpper),
+			s.Status,
+		)
+	}
+	t.Output(os.Stdout)
+}
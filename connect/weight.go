@@ -10,10 +10,17 @@ import (
 	"github.com/spf13/cobra"
 )
 
-func init() {
-	weightCmd := &cobra.Command{
+var (
+	weightCmd = &cobra.Command{
 		Use: "weight",
 	}
+
+	weightTime        string
+	weightComposition connect.BodyComposition
+	weightDeleteAll   bool
+)
+
+func init() {
 	rootCmd.AddCommand(weightCmd)
 
 	weightLatestCmd := &cobra.Command{
@@ -34,20 +41,47 @@ func init() {
 
 	weightAddCmd := &cobra.Command{
 		Use:   "add <yyyy-mm-dd> <weight in grams>",
-		Short: "Add a simple weight for a specific date",
+		Short: "Add a weight-in for a specific date, optionally with body composition",
 		Run:   weightAdd,
 		Args:  cobra.ExactArgs(2),
 	}
+	weightAddCmd.Flags().StringVar(&weightTime, "time", "", "Local time of day of the weigh-in (hh:mm)")
+	weightAddCmd.Flags().Float64Var(&weightComposition.BMI, "bmi", 0.0, "Body mass index")
+	weightAddCmd.Flags().Float64Var(&weightComposition.BodyFatPercentage, "fat", 0.0, "Body fat in percent")
+	weightAddCmd.Flags().Float64Var(&weightComposition.BodyWaterPercentage, "water", 0.0, "Body water in percent")
+	weightAddCmd.Flags().Float64Var(&weightComposition.BoneMass, "bone", 0.0, "Bone mass in grams")
+	weightAddCmd.Flags().Float64Var(&weightComposition.MuscleMass, "muscle", 0.0, "Muscle mass in grams")
+	weightAddCmd.Flags().IntVar(&weightComposition.VisceralFatRating, "visceral-fat", 0, "Visceral fat rating")
+	weightAddCmd.Flags().IntVar(&weightComposition.MetabolicAge, "metabolic-age", 0, "Metabolic age in years")
+	weightAddCmd.Flags().IntVar(&weightComposition.PhysiqueRating, "physique", 0, "Physique rating")
 	weightCmd.AddCommand(weightAddCmd)
 
 	weightDeleteCmd := &cobra.Command{
-		Use:   "delete <yyyy-mm-dd]>",
-		Short: "Delete a weight-in",
+		Use:   "delete <yyyy-mm-dd>",
+		Short: "Delete a weight-in, asks which one if there is more than one",
 		Run:   weightDelete,
 		Args:  cobra.ExactArgs(1),
 	}
+	weightDeleteCmd.Flags().BoolVar(&weightDeleteAll, "all", false, "Delete all biometric data for the date")
 	weightCmd.AddCommand(weightDeleteCmd)
 
+	weightEditCmd := &cobra.Command{
+		Use:   "edit <yyyy-mm-dd> <weight in grams>",
+		Short: "Change the weight of a weight-in, asks which one if there is more than one",
+		Run:   weightEdit,
+		Args:  cobra.ExactArgs(2),
+	}
+	weightEditCmd.Flags().StringVar(&weightTime, "time", "", "New local time of day of the weigh-in (hh:mm)")
+	weightCmd.AddCommand(weightEditCmd)
+
+	weightDayCmd := &cobra.Command{
+		Use:   "day <yyyy-mm-dd>",
+		Short: "List individual weight-ins for a date",
+		Run:   weightDay,
+		Args:  cobra.ExactArgs(1),
+	}
+	weightCmd.AddCommand(weightDayCmd)
+
 	weightDateCmd := &cobra.Command{
 		Use:   "date [yyyy-mm-dd]",
 		Short: "Show weight for a specific date",
@@ -123,7 +157,26 @@ func weightAdd(_ *cobra.Command, args []string) {
 	weight, err := strconv.Atoi(args[1])
 	bail(err)
 
-	err = client.AddUserWeight(date.Time(), float64(weight))
+	// Use the simple API unless we need a time of day or body composition.
+	if weightTime == "" && weightComposition == (connect.BodyComposition{}) {
+		err = client.AddUserWeight(date.Time(), float64(weight))
+		bail(err)
+
+		return
+	}
+
+	timestamp := time.Date(date.Year, date.Month, date.DayOfMonth, 12, 0, 0, 0, time.Local)
+	if weightTime != "" {
+		clock, err := time.Parse("15:04", weightTime)
+		bail(err)
+
+		timestamp = time.Date(date.Year, date.Month, date.DayOfMonth, clock.Hour(), clock.Minute(), 0, 0, time.Local)
+	}
+
+	weightComposition.Timestamp = timestamp
+	weightComposition.Weight = float64(weight)
+
+	err = client.AddWeightin(weightComposition)
 	bail(err)
 }
 
@@ -131,10 +184,95 @@ func weightDelete(_ *cobra.Command, args []string) {
 	date, err := connect.ParseDate(args[0])
 	bail(err)
 
-	err = client.DeleteWeightin(date.Time())
+	if weightDeleteAll {
+		err = client.DeleteWeightin(date.Time())
+		bail(err)
+
+		return
+	}
+
+	weightin := pickWeightin(date, "delete")
+
+	err = client.DeleteWeightinSample(date.Time(), weightin.SamplePK)
 	bail(err)
 }
 
+func weightEdit(_ *cobra.Command, args []string) {
+	date, err := connect.ParseDate(args[0])
+	bail(err)
+
+	weight, err := strconv.Atoi(args[1])
+	bail(err)
+
+	weightin := pickWeightin(date, "edit")
+
+	timestamp := weightin.Timestamp.Local()
+	if weightTime != "" {
+		clock, err := time.Parse("15:04", weightTime)
+		bail(err)
+
+		timestamp = time.Date(date.Year, date.Month, date.DayOfMonth, clock.Hour(), clock.Minute(), 0, 0, time.Local)
+	}
+
+	err = client.UpdateWeightin(weightin, timestamp, float64(weight))
+	bail(err)
+}
+
+func weightDay(_ *cobra.Command, args []string) {
+	date, err := connect.ParseDate(args[0])
+	bail(err)
+
+	weightins, err := client.WeightinsByDate(date.Time())
+	bail(err)
+
+	outputWeightins(weightins)
+}
+
+func outputWeightins(weightins []connect.Weightin) {
+	t := NewTable()
+	t.AddHeader("#", "Sample PK", "Time", "Weight", "Fat%", "Source")
+	for i, w := range weightins {
+		t.AddRow(
+			i+1,
+			w.SamplePK,
+			w.Timestamp.Local().Format("15:04:05"),
+			w.Weight/1000.0,
+			nzf(w.BodyFatPercentage),
+			w.SourceType,
+		)
+	}
+	t.Output(os.Stdout)
+}
+
+// pickWeightin will return the single weigh-in on date. If there's more
+// than one, the user will be asked to pick one.
+func pickWeightin(date connect.Date, verb string) connect.Weightin {
+	weightins, err := client.WeightinsByDate(date.Time())
+	bail(err)
+
+	switch len(weightins) {
+	case 0:
+		fmt.Printf("No weight ins on this date\n")
+		os.Exit(1)
+	case 1:
+		return weightins[0]
+	}
+
+	outputWeightins(weightins)
+
+	fmt.Printf("\nWeigh-in to %s (1-%d): ", verb, len(weightins))
+
+	var choice int
+	_, err = fmt.Scanln(&choice)
+	bail(err)
+
+	if choice < 1 || choice > len(weightins) {
+		bail(fmt.Errorf("no weigh-in #%d", choice))
+	}
+
+	return weightins[choice-1]
+}
+
 func weightDate(_ *cobra.Command, args []string) {
 	date, err := connect.ParseDate(args[0])
 	bail(err)
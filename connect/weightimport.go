@@ -0,0 +1,323 @@
+package main
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/spf13/cobra"
+
+	connect "github.com/abrander/garmin-connect"
+)
+
+// weightColumns maps the columns of a CSV export to body composition
+// metrics. Empty columns are ignored.
+type weightColumns struct {
+	Date         string
+	Weight       string
+	BMI          string
+	Fat          string
+	FatMass      string
+	Water        string
+	WaterMass    string
+	Bone         string
+	Muscle       string
+	VisceralFat  string
+	MetabolicAge string
+}
+
+var (
+	weightImportPresets = map[string]weightColumns{
+		"generic": {
+			Date:   "Date",
+			Weight: "Weight",
+		},
+		"withings": {
+			Date:      "Date",
+			Weight:    "Weight (kg)",
+			FatMass:   "Fat mass (kg)",
+			WaterMass: "Hydration (kg)",
+			Bone:      "Bone mass (kg)",
+			Muscle:    "Muscle mass (kg)",
+		},
+		"renpho": {
+			Date:         "Time of Measurement",
+			Weight:       "Weight(kg)",
+			BMI:          "BMI",
+			Fat:          "Body Fat(%)",
+			Water:        "Body Water(%)",
+			Bone:         "Bone Mass(kg)",
+			Muscle:       "Muscle Mass(kg)",
+			VisceralFat:  "Visceral Fat",
+			MetabolicAge: "Metabolic Age",
+		},
+	}
+
+	// weightImportLayouts is the date layouts tried if no layout is given.
+	weightImportLayouts = []string{
+		"2006-01-02 15:04:05",
+		"2006-01-02 15:04",
+		"2006-01-02T15:04:05",
+		"2006-01-02",
+		"2006.01.02 15:04:05",
+		"01/02/2006 15:04:05",
+		"01/02/2006, 15:04:05",
+		"01/02/2006",
+	}
+
+	weightImportPreset     string
+	weightImportColumns    weightColumns
+	weightImportUnit       string
+	weightImportDateFormat string
+	weightImportDelimiter  string
+	weightImportDryRun     bool
+)
+
+func init() {
+	weightImportCmd := &cobra.Command{
+		Use:   "import <file>",
+		Short: "Import weigh-ins from a CSV export",
+		Run:   weightImport,
+		Args:  cobra.ExactArgs(1),
+	}
+	weightImportCmd.Flags().StringVar(&weightImportPreset, "preset", "generic", "Column preset (generic, withings, renpho)")
+	weightImportCmd.Flags().StringVar(&weightImportColumns.Date, "date-column", "", "Column with date and time")
+	weightImportCmd.Flags().StringVar(&weightImportColumns.Weight, "weight-column", "", "Column with weight")
+	weightImportCmd.Flags().StringVar(&weightImportColumns.BMI, "bmi-column", "", "Column with body mass index")
+	weightImportCmd.Flags().StringVar(&weightImportColumns.Fat, "fat-column", "", "Column with body fat in percent")
+	weightImportCmd.Flags().StringVar(&weightImportColumns.FatMass, "fat-mass-column", "", "Column with body fat mass")
+	weightImportCmd.Flags().StringVar(&weightImportColumns.Water, "water-column", "", "Column with body water in percent")
+	weightImportCmd.Flags().StringVar(&weightImportColumns.WaterMass, "water-mass-column", "", "Column with body water mass")
+	weightImportCmd.Flags().StringVar(&weightImportColumns.Bone, "bone-column", "", "Column with bone mass")
+	weightImportCmd.Flags().StringVar(&weightImportColumns.Muscle, "muscle-column", "", "Column with muscle mass")
+	weightImportCmd.Flags().StringVar(&weightImportColumns.VisceralFat, "visceral-fat-column", "", "Column with visceral fat rating")
+	weightImportCmd.Flags().StringVar(&weightImportColumns.MetabolicAge, "metabolic-age-column", "", "Column with metabolic age")
+	weightImportCmd.Flags().StringVar(&weightImportUnit, "unit", "kg", "Unit of masses in the file (kg, lb, g)")
+	weightImportCmd.Flags().StringVar(&weightImportDateFormat, "date-format", "", "Date layout in Go format, guessed if empty")
+	weightImportCmd.Flags().StringVar(&weightImportDelimiter, "delimiter", ",", "Field delimiter")
+	weightImportCmd.Flags().BoolVar(&weightImportDryRun, "dry-run", false, "Only show what would be imported")
+	weightCmd.AddCommand(weightImportCmd)
+}
+
+// merge returns c with empty columns taken from preset.
+func (c weightColumns) merge(preset weightColumns) weightColumns {
+	fields := []struct{ dst, src *string }{
+		{&c.Date, &preset.Date},
+		{&c.Weight, &preset.Weight},
+		{&c.BMI, &preset.BMI},
+		{&c.Fat, &preset.Fat},
+		{&c.FatMass, &preset.FatMass},
+		{&c.Water, &preset.Water},
+		{&c.WaterMass, &preset.WaterMass},
+		{&c.Bone, &preset.Bone},
+		{&c.Muscle, &preset.Muscle},
+		{&c.VisceralFat, &preset.VisceralFat},
+		{&c.MetabolicAge, &preset.MetabolicAge},
+	}
+
+	for _, f := range fields {
+		if *f.dst == "" {
+			*f.dst = *f.src
+		}
+	}
+
+	return c
+}
+
+// parseWeightDate parses a date from a CSV export in the local time zone.
+func parseWeightDate(value string) (time.Time, error) {
+	layouts := weightImportLayouts
+	if weightImportDateFormat != "" {
+		layouts = []string{weightImportDateFormat}
+	}
+
+	for _, layout := range layouts {
+		t, err := time.ParseInLocation(layout, value, time.Local)
+		if err == nil {
+			return t, nil
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("unable to parse date '%s'", value)
+}
+
+// readWeightCSV will read body compositions from r using columns.
+func readWeightCSV(r io.Reader, columns weightColumns, gramsPerUnit float64) ([]connect.BodyComposition, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.Comma, _ = utf8.DecodeRuneInString(weightImportDelimiter)
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, err
+	}
+
+	index := make(map[string]int)
+	for i, title := range header {
+		title = strings.TrimPrefix(title, "\ufeff")
+		index[strings.TrimSpace(title)] = i
+	}
+
+	if _, found := index[columns.Date]; !found {
+		return nil, fmt.Errorf("date column '%s' not found", columns.Date)
+	}
+
+	if _, found := index[columns.Weight]; !found {
+		return nil, fmt.Errorf("weight column '%s' not found", columns.Weight)
+	}
+
+	var weightins []connect.BodyComposition
+
+	// The header is line 1.
+	line := 1
+
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		line++
+
+		// Empty cells have no value, anything else must be a number. The
+		// first error is kept in valueErr.
+		var valueErr error
+		value := func(column string) float64 {
+			i, found := index[column]
+			if column == "" || !found || i >= len(record) {
+				return 0.0
+			}
+
+			str := strings.TrimSpace(record[i])
+			if str == "" {
+				return 0.0
+			}
+
+			v, err := strconv.ParseFloat(strings.Replace(str, ",", ".", 1), 64)
+			if err != nil && valueErr == nil {
+				valueErr = fmt.Errorf("line %d, column '%s': '%s' is not a number", line, column, str)
+			}
+
+			return v
+		}
+
+		weight := value(columns.Weight) * gramsPerUnit
+		if valueErr != nil {
+			return nil, valueErr
+		}
+
+		if weight <= 0.0 {
+			continue
+		}
+
+		timestamp, err := parseWeightDate(strings.TrimSpace(record[index[columns.Date]]))
+		if err != nil {
+			return nil, fmt.Errorf("line %d, column '%s': %s", line, columns.Date, err.Error())
+		}
+
+		w := connect.BodyComposition{
+			Timestamp:           timestamp,
+			Weight:              weight,
+			BMI:                 value(columns.BMI),
+			BodyFatPercentage:   value(columns.Fat),
+			BodyWaterPercentage: value(columns.Water),
+			BoneMass:            value(columns.Bone) * gramsPerUnit,
+			MuscleMass:          value(columns.Muscle) * gramsPerUnit,
+			VisceralFatRating:   int(value(columns.VisceralFat)),
+			MetabolicAge:        int(value(columns.MetabolicAge)),
+		}
+
+		if fatMass := value(columns.FatMass) * gramsPerUnit; fatMass > 0.0 {
+			w.BodyFatPercentage = 100.0 * fatMass / weight
+		}
+
+		if waterMass := value(columns.WaterMass) * gramsPerUnit; waterMass > 0.0 {
+			w.BodyWaterPercentage = 100.0 * waterMass / weight
+		}
+
+		if valueErr != nil {
+			return nil, valueErr
+		}
+
+		weightins = append(weightins, w)
+	}
+
+	sort.Slice(weightins, func(i, j int) bool {
+		return weightins[i].Timestamp.Before(weightins[j].Timestamp)
+	})
+
+	return weightins, nil
+}
+
+func weightImport(_ *cobra.Command, args []string) {
+	preset, found := weightImportPresets[weightImportPreset]
+	if !found {
+		bail(fmt.Errorf("unknown preset '%s'", weightImportPreset))
+	}
+
+	gramsPerUnit := map[string]float64{
+		"kg": 1000.0,
+		"lb": 453.59237,
+		"g":  1.0,
+	}[weightImportUnit]
+	if gramsPerUnit == 0.0 {
+		bail(fmt.Errorf("unknown unit '%s'", weightImportUnit))
+	}
+
+	f, err := os.Open(args[0])
+	bail(err)
+	defer f.Close()
+
+	weightins, err := readWeightCSV(f, weightImportColumns.merge(preset), gramsPerUnit)
+	bail(err)
+
+	if len(weightins) == 0 {
+		fmt.Printf("No weigh-ins found\n")
+		return
+	}
+
+	_, existing, err := client.Weightins(weightins[0].Timestamp, weightins[len(weightins)-1].Timestamp)
+	bail(err)
+
+	exists := make(map[connect.Date]bool)
+	for _, w := range existing {
+		if w.Weight > 0.0 {
+			exists[w.Date] = true
+		}
+	}
+
+	t := NewTable()
+	t.AddHeader("Time", "Weight", "BMI", "Fat%", "Water%", "Bone Mass", "Muscle Mass", "Action")
+	for _, w := range weightins {
+		action := "add"
+		if exists[connect.NewDate(w.Timestamp)] {
+			action = "skip"
+		}
+
+		t.AddRow(
+			w.Timestamp.Format("2006-01-02 15:04"),
+			w.Weight/1000.0,
+			nzf(w.BMI),
+			nzf(w.BodyFatPercentage),
+			nzf(w.BodyWaterPercentage),
+			nzf(w.BoneMass/1000.0),
+			nzf(w.MuscleMass/1000.0),
+			action,
+		)
+
+		if action == "add" && !weightImportDryRun {
+			err = client.AddWeightin(w)
+			bail(err)
+		}
+	}
+	t.Output(os.Stdout)
+}
@@ -0,0 +1,89 @@
+package main
+
+import (
+	"fmt"
+	"math"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	connect "github.com/abrander/garmin-connect"
+)
+
+var (
+	weightTrendSince string
+	weightTrendAlpha float64
+)
+
+func init() {
+	weightTrendCmd := &cobra.Command{
+		Use:   "trend",
+		Short: "Show smoothed weight trend and goal forecast",
+		Run:   weightTrend,
+		Args:  cobra.NoArgs,
+	}
+	weightTrendCmd.Flags().StringVar(&weightTrendSince, "since", "", "First date to include (yyyy-mm-dd), defaults to 90 days ago")
+	weightTrendCmd.Flags().Float64Var(&weightTrendAlpha, "alpha", 0.1, "Smoothing factor per day")
+	weightCmd.AddCommand(weightTrendCmd)
+}
+
+func weightTrend(_ *cobra.Command, _ []string) {
+	until := time.Now()
+	since := parseDateFlag(weightTrendSince, until.AddDate(0, 0, -90))
+
+	_, weightins, err := client.Weightins(since, until)
+	bail(err)
+
+	trend := connect.AnalyzeWeight(weightins, weightTrendAlpha)
+	if len(trend.Points) == 0 {
+		fmt.Printf("No weigh-ins found\n")
+		return
+	}
+
+	current := trend.Current()
+
+	t := NewTabular()
+	t.AddValueUnit("Trend", current.Trend/1000.0, "kg")
+	t.AddValueUnit("Weekly Rate", trend.WeeklyRate/1000.0, "kg")
+	t.AddValueUnit("Std. Deviation", math.Sqrt(trend.Variance)/1000.0, "kg")
+
+	goal, err := client.WeightGoal("")
+	if err == nil {
+		t.AddValueUnit("Goal", float64(goal.Value)/1000.0, "kg")
+
+		when, ok := trend.ProjectGoal(float64(goal.Value))
+		if ok {
+			t.AddValue("Projected", formatDate(when))
+		} else {
+			t.AddValue("Projected", "-")
+		}
+	} else if err != connect.ErrNotFound {
+		bail(err)
+	}
+	t.Output(os.Stdout)
+	fmt.Printf("\n")
+
+	// Lay out one column per day, so gaps are visible in the chart.
+	first := trend.Points[0].Date.Time()
+	days := int(current.Date.Time().Sub(first).Hours()/24) + 1
+
+	weights := make([]float64, days)
+	trendline := make([]float64, days)
+	for i := range weights {
+		weights[i] = math.NaN()
+		trendline[i] = math.NaN()
+	}
+
+	for _, p := range trend.Points {
+		i := int(p.Date.Time().Sub(first).Hours() / 24)
+		weights[i] = p.Weight / 1000.0
+		trendline[i] = p.Trend / 1000.0
+	}
+
+	chart := NewChart(90, 15)
+	chart.AddSeries('·', weights)
+	chart.AddSeries('●', trendline)
+	chart.Output(os.Stdout)
+	fmt.Printf("%10s%s - %s\n", "", formatDate(first), current.Date)
+}
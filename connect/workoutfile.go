@@ -0,0 +1,150 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v2"
+
+	connect "github.com/abrander/garmin-connect"
+)
+
+// workoutFile is a human-friendly workout definition. Steps are parsed by
+// connect.ParseWorkout.
+type workoutFile struct {
+	ID          int64    `yaml:"id" json:"id"`
+	Name        string   `yaml:"name" json:"name"`
+	Sport       string   `yaml:"sport" json:"sport"`
+	Description string   `yaml:"description" json:"description"`
+	Steps       []string `yaml:"steps" json:"steps"`
+}
+
+var (
+	workoutsExportFIT bool
+)
+
+func init() {
+	workoutsPushCmd := &cobra.Command{
+		Use:   "push <file>",
+		Short: "Create or update a workout from a YAML or JSON file",
+		Run:   workoutsPush,
+		Args:  cobra.ExactArgs(1),
+	}
+	workoutsCmd.AddCommand(workoutsPushCmd)
+
+	workoutsExportCmd := &cobra.Command{
+		Use:   "export <workout id>",
+		Short: "Export a workout to a JSON or FIT file",
+		Run:   workoutsExport,
+		Args:  cobra.ExactArgs(1),
+	}
+	workoutsExportCmd.Flags().BoolVar(&workoutsExportFIT, "fit", false, "Export as a FIT workout file for copying to a device")
+	workoutsCmd.AddCommand(workoutsExportCmd)
+}
+
+// readWorkoutFile will read a workout definition from filename. The format
+// is deduced from the extension.
+func readWorkoutFile(filename string) (*workoutFile, error) {
+	data, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	file := &workoutFile{
+		Sport: connect.SportRunning.Key,
+	}
+
+	switch strings.ToLower(filepath.Ext(filename)) {
+	case ".json":
+		dec := json.NewDecoder(bytes.NewReader(data))
+		dec.DisallowUnknownFields()
+		err = dec.Decode(file)
+	case ".yaml", ".yml":
+		err = yaml.UnmarshalStrict(data, file)
+	default:
+		err = fmt.Errorf("unknown file type '%s'", filepath.Ext(filename))
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if file.Name == "" {
+		return nil, fmt.Errorf("%s: workout has no name", filename)
+	}
+
+	return file, nil
+}
+
+func workoutsPush(_ *cobra.Command, args []string) {
+	file, err := readWorkoutFile(args[0])
+	bail(err)
+
+	sport, err := connect.ParseWorkoutSport(file.Sport)
+	bail(err)
+
+	workout, err := connect.ParseWorkout(file.Name, sport, strings.Join(file.Steps, ";"))
+	bail(err)
+
+	workout.Description = file.Description
+	workout.ID = file.ID
+
+	// Update workouts with the same name if no ID is given.
+	if workout.ID == 0 {
+		workouts, err := client.Workouts(0, 1000)
+		bail(err)
+
+		for _, w := range workouts {
+			if w.Name == workout.Name {
+				workout.ID = w.ID
+				break
+			}
+		}
+	}
+
+	if workout.ID != 0 {
+		err = client.UpdateWorkout(workout)
+		bail(err)
+
+		fmt.Printf("Workout ID %d updated\n", workout.ID)
+
+		return
+	}
+
+	created, err := client.CreateWorkout(workout)
+	bail(err)
+
+	fmt.Printf("Workout ID %d created\n", created.ID)
+}
+
+func workoutsExport(_ *cobra.Command, args []string) {
+	id := idArg(args[0])
+
+	workout, err := client.Workout(id)
+	bail(err)
+
+	extension := "json"
+	if workoutsExportFIT {
+		extension = "fit"
+	}
+
+	// The workout is buffered to avoid leaving an empty file on errors.
+	buffer := bytes.NewBuffer(nil)
+
+	if workoutsExportFIT {
+		err = workout.WriteFIT(buffer)
+	} else {
+		enc := json.NewEncoder(buffer)
+		enc.SetIndent("", "  ")
+		err = enc.Encode(workout)
+	}
+	bail(err)
+
+	name := fmt.Sprintf("%d.%s", id, extension)
+	err = ioutil.WriteFile(name, buffer.Bytes(), 0644)
+	bail(err)
+}
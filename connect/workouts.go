@@ -0,0 +1,159 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	connect "github.com/abrander/garmin-connect"
+)
+
+var (
+	workoutsCmd = &cobra.Command{
+		Use: "workouts",
+	}
+)
+
+func init() {
+	rootCmd.AddCommand(workoutsCmd)
+
+	workoutsListCmd := &cobra.Command{
+		Use:   "list",
+		Short: "List workouts in the workout library",
+		Run:   workoutsList,
+		Args:  cobra.NoArgs,
+	}
+	workoutsCmd.AddCommand(workoutsListCmd)
+
+	workoutsViewCmd := &cobra.Command{
+		Use:   "view <workout id>",
+		Short: "Show a workout with all steps",
+		Run:   workoutsView,
+		Args:  cobra.ExactArgs(1),
+	}
+	workoutsCmd.AddCommand(workoutsViewCmd)
+
+	workoutsDeleteCmd := &cobra.Command{
+		Use:   "delete <workout id>",
+		Short: "Delete a workout",
+		Run:   workoutsDelete,
+		Args:  cobra.ExactArgs(1),
+	}
+	workoutsCmd.AddCommand(workoutsDeleteCmd)
+}
+
+func workoutsList(_ *cobra.Command, _ []string) {
+	workouts, err := client.Workouts(0, 1000)
+	bail(err)
+
+	t := NewTable()
+	t.AddHeader("ID", "Name", "Sport", "Duration", "Updated")
+	for _, w := range workouts {
+		updated := "-"
+		if w.Updated != nil {
+			updated = formatDate(w.Updated.Time)
+		}
+
+		t.AddRow(
+			w.ID,
+			w.Name,
+			w.SportType.Key,
+			hoursAndMinutes(time.Duration(w.EstimatedDuration)*time.Second),
+			updated,
+		)
+	}
+	t.Output(os.Stdout)
+}
+
+func workoutsView(_ *cobra.Command, args []string) {
+	workout, err := client.Workout(idArg(args[0]))
+	bail(err)
+
+	t := NewTabular()
+	t.AddValue("ID", workout.ID)
+	t.AddValue("Name", workout.Name)
+	t.AddValue("Sport", workout.SportType.Key)
+	t.AddValue("Description", workout.Description)
+	t.AddValue("Duration", hoursAndMinutes(time.Duration(workout.EstimatedDuration)*time.Second))
+	t.Output(os.Stdout)
+	fmt.Printf("\n")
+
+	steps := NewTable()
+	steps.AddHeader("#", "Step", "End", "Target")
+	for _, segment := range workout.Segments {
+		addWorkoutSteps(steps, segment.Steps, 0)
+	}
+	steps.Output(os.Stdout)
+}
+
+// addWorkoutSteps will add steps to t. Repeated steps are indented.
+func addWorkoutSteps(t *Table, steps []connect.WorkoutStep, depth int) {
+	indent := strings.Repeat("  ", depth)
+
+	for _, s := range steps {
+		if s.IsRepeat() {
+			t.AddRow(s.Order, indent+fmt.Sprintf("repeat %dx", s.Iterations), "", "")
+			addWorkoutSteps(t, s.Steps, depth+1)
+
+			continue
+		}
+
+		t.AddRow(s.Order, indent+s.StepType.Key, workoutEnd(s), workoutTarget(s))
+	}
+}
+
+// workoutEnd describes the end condition of a step.
+func workoutEnd(s connect.WorkoutStep) string {
+	switch s.EndCondition {
+	case connect.EndTime:
+		return (time.Duration(s.EndConditionValue) * time.Second).String()
+	case connect.EndDistance:
+		return fmt.Sprintf("%.0fm", s.EndConditionValue)
+	case connect.EndCalories:
+		return fmt.Sprintf("%.0fkcal", s.EndConditionValue)
+	case connect.EndLapButton:
+		return "lap button"
+	}
+
+	return fmt.Sprintf("%s %.0f", s.EndCondition.Key, s.EndConditionValue)
+}
+
+// workoutTarget describes the target of a step.
+func workoutTarget(s connect.WorkoutStep) string {
+	if s.TargetType == nil || *s.TargetType == connect.TargetNone {
+		return "-"
+	}
+
+	if s.ZoneNumber > 0 {
+		return fmt.Sprintf("%s %d", s.TargetType.Key, s.ZoneNumber)
+	}
+
+	switch *s.TargetType {
+	case connect.TargetPace:
+		pace := func(speed float64) string {
+			if speed <= 0.0 {
+				return "-"
+			}
+
+			d := time.Duration(1000.0/speed) * time.Second
+
+			return fmt.Sprintf("%d:%02d", int(d.Minutes()), int(d.Seconds())%60)
+		}
+
+		return fmt.Sprintf("%s-%s/km", pace(s.TargetValueOne), pace(s.TargetValueTwo))
+	case connect.TargetHeartRate:
+		return fmt.Sprintf("%.0f-%.0f bpm", s.TargetValueOne, s.TargetValueTwo)
+	case connect.TargetPower:
+		return fmt.Sprintf("%.0f-%.0f W", s.TargetValueOne, s.TargetValueTwo)
+	}
+
+	return fmt.Sprintf("%s %.1f-%.1f", s.TargetType.Key, s.TargetValueOne, s.TargetValueTwo)
+}
+
+func workoutsDelete(_ *cobra.Command, args []string) {
+	err := client.DeleteWorkout(idArg(args[0]))
+	bail(err)
+}
@@ -6,6 +6,7 @@ import (
 	"io"
 	"io/ioutil"
 	"net/http"
+	"sync"
 	"time"
 )
 
@@ -35,3 +36,68 @@ func drainBody(b io.ReadCloser) (r1, r2 io.ReadCloser, err error) {
 	}
 	return ioutil.NopCloser(&buf), ioutil.NopCloser(bytes.NewReader(buf.Bytes())), nil
 }
+
+// dayWorkers is the number of concurrent requests used when fetching data
+// for multiple days.
+const dayWorkers = 4
+
+// forEachDay will call fn for every day between from and to (both
+// inclusive). i is the index of the day starting at 0, so results can be
+// stored in order. fn will be called concurrently by up to dayWorkers
+// goroutines. The first error returned by fn will stop the iteration and be
+// returned.
+func forEachDay(from time.Time, to time.Time, fn func(i int, date time.Time) error) error {
+	first := NewDate(from).Time()
+	last := NewDate(to).Time()
+
+	days := make(chan int)
+
+	var lock sync.Mutex
+	var firstErr error
+
+	failed := func() bool {
+		lock.Lock()
+		defer lock.Unlock()
+
+		return firstErr != nil
+	}
+
+	var wg sync.WaitGroup
+	for w := 0; w < dayWorkers; w++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for i := range days {
+				err := fn(i, first.AddDate(0, 0, i))
+				if err != nil {
+					lock.Lock()
+					if firstErr == nil {
+						firstErr = err
+					}
+					lock.Unlock()
+				}
+			}
+		}()
+	}
+
+	for i := 0; !first.AddDate(0, 0, i).After(last) && !failed(); i++ {
+		days <- i
+	}
+	close(days)
+
+	wg.Wait()
+
+	return firstErr
+}
+
+// numDays returns the number of days between from and to (both inclusive).
+func numDays(from time.Time, to time.Time) int {
+	n := int(NewDate(to).Time().Sub(NewDate(from).Time()).Hours()/24) + 1
+	if n < 0 {
+		return 0
+	}
+
+	return n
+}
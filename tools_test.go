@@ -0,0 +1,63 @@
+package connect
+
+import (
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestForEachDay(t *testing.T) {
+	from := time.Date(2020, 1, 30, 12, 0, 0, 0, time.UTC)
+	to := time.Date(2020, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	n := numDays(from, to)
+	if n != 32 {
+		t.Fatalf("Expected 32 days, got %d", n)
+	}
+
+	dates := make([]Date, n)
+
+	err := forEachDay(from, to, func(i int, date time.Time) error {
+		dates[i] = NewDate(date)
+
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("forEachDay() returned %s", err.Error())
+	}
+
+	for i, d := range dates {
+		expected := NewDate(from.AddDate(0, 0, i))
+		if d != expected {
+			t.Errorf("Expected %s at index %d, got %s", expected, i, d)
+		}
+	}
+}
+
+func TestForEachDayError(t *testing.T) {
+	from := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(1, 0, 0)
+
+	failure := errors.New("failure")
+
+	var calls int32
+
+	err := forEachDay(from, to, func(i int, _ time.Time) error {
+		atomic.AddInt32(&calls, 1)
+
+		if i == 3 {
+			return failure
+		}
+
+		return nil
+	})
+	if err != failure {
+		t.Errorf("Expected the error from fn, got %v", err)
+	}
+
+	// Days are no longer handed out after the error.
+	if int(calls) >= numDays(from, to) {
+		t.Errorf("Expected iteration to stop after the error, got %d calls", calls)
+	}
+}